@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.5
+// 	protoc-gen-go v1.36.6
 // 	protoc        (unknown)
 // source: external/lobby/v1/lobby.proto
 
@@ -9,6 +9,7 @@ package lobbyv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -31,6 +32,8 @@ const (
 	Status_STATUS_STARTING    Status = 2 // Lobby is ready to start a game
 	Status_STATUS_TIMEOUT     Status = 3 // Lobby is expired and player should find another one
 	Status_STATUS_ERROR       Status = 4 // Some error in a lobby, lobby not working more
+	Status_STATUS_READY_CHECK Status = 5 // Lobby is ready and waits for every player to accept a match
+	Status_STATUS_RECONNECT   Status = 6 // Instance is draining, player keeps the seat and should reattach to the lobby elsewhere
 )
 
 // Enum value maps for Status.
@@ -41,6 +44,8 @@ var (
 		2: "STATUS_STARTING",
 		3: "STATUS_TIMEOUT",
 		4: "STATUS_ERROR",
+		5: "STATUS_READY_CHECK",
+		6: "STATUS_RECONNECT",
 	}
 	Status_value = map[string]int32{
 		"STATUS_UNSPECIFIED": 0,
@@ -48,6 +53,8 @@ var (
 		"STATUS_STARTING":    2,
 		"STATUS_TIMEOUT":     3,
 		"STATUS_ERROR":       4,
+		"STATUS_READY_CHECK": 5,
+		"STATUS_RECONNECT":   6,
 	}
 )
 
@@ -86,6 +93,9 @@ type JoinLobbyRequest struct {
 	Rating        int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`                                     // Rating of a player
 	CategoryIds   []int32                `protobuf:"varint,3,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"` // Desired categories ids
 	Mode          string                 `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`                                          // Selected game mode for playing
+	PartyId       string                 `protobuf:"bytes,5,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`                     // ID of party, if player is queueing together with friends, by default is empty
+	PartyMembers  []*PartyMember         `protobuf:"bytes,6,rep,name=party_members,json=partyMembers,proto3" json:"party_members,omitempty"`      // Other party members, set only by party leader, members join with party_id only
+	FallbackModes []string               `protobuf:"bytes,7,rep,name=fallback_modes,json=fallbackModes,proto3" json:"fallback_modes,omitempty"`   // Ordered list of other acceptable game modes, used when selected mode has no compatible lobby
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -148,6 +158,663 @@ func (x *JoinLobbyRequest) GetMode() string {
 	return ""
 }
 
+func (x *JoinLobbyRequest) GetPartyId() string {
+	if x != nil {
+		return x.PartyId
+	}
+	return ""
+}
+
+func (x *JoinLobbyRequest) GetPartyMembers() []*PartyMember {
+	if x != nil {
+		return x.PartyMembers
+	}
+	return nil
+}
+
+func (x *JoinLobbyRequest) GetFallbackModes() []string {
+	if x != nil {
+		return x.FallbackModes
+	}
+	return nil
+}
+
+// *
+// Represents a party member who is queued by party leader
+type PartyMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`                  // ID of party member
+	Rating        int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`                                     // Rating of party member
+	CategoryIds   []int32                `protobuf:"varint,3,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"` // Desired categories ids of party member
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyMember) Reset() {
+	*x = PartyMember{}
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyMember) ProtoMessage() {}
+
+func (x *PartyMember) ProtoReflect() protoreflect.Message {
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyMember.ProtoReflect.Descriptor instead.
+func (*PartyMember) Descriptor() ([]byte, []int) {
+	return file_external_lobby_v1_lobby_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PartyMember) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PartyMember) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *PartyMember) GetCategoryIds() []int32 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+// *
+// Represents a request argument for leaving a lobby
+type LeaveLobbyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LobbyId       string                 `protobuf:"bytes,1,opt,name=lobby_id,json=lobbyId,proto3" json:"lobby_id,omitempty"`    // ID of lobby where is a player
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // ID of player who leaves a lobby
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveLobbyRequest) Reset() {
+	*x = LeaveLobbyRequest{}
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveLobbyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveLobbyRequest) ProtoMessage() {}
+
+func (x *LeaveLobbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveLobbyRequest.ProtoReflect.Descriptor instead.
+func (*LeaveLobbyRequest) Descriptor() ([]byte, []int) {
+	return file_external_lobby_v1_lobby_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LeaveLobbyRequest) GetLobbyId() string {
+	if x != nil {
+		return x.LobbyId
+	}
+	return ""
+}
+
+func (x *LeaveLobbyRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+// *
+// Represents a request argument for answering a ready check
+type MatchResponseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LobbyId       string                 `protobuf:"bytes,1,opt,name=lobby_id,json=lobbyId,proto3" json:"lobby_id,omitempty"`    // ID of lobby in ready check
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // ID of player who answers a ready check
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchResponseRequest) Reset() {
+	*x = MatchResponseRequest{}
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchResponseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchResponseRequest) ProtoMessage() {}
+
+func (x *MatchResponseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchResponseRequest.ProtoReflect.Descriptor instead.
+func (*MatchResponseRequest) Descriptor() ([]byte, []int) {
+	return file_external_lobby_v1_lobby_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MatchResponseRequest) GetLobbyId() string {
+	if x != nil {
+		return x.LobbyId
+	}
+	return ""
+}
+
+func (x *MatchResponseRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+// *
+// Represents a request argument for getting queue statistics
+type GetQueueStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"` // Game mode of queue
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueueStatsRequest) Reset() {
+	*x = GetQueueStatsRequest{}
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueueStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueStatsRequest) ProtoMessage() {}
+
+func (x *GetQueueStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetQueueStatsRequest) Descriptor() ([]byte, []int) {
+	return file_external_lobby_v1_lobby_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetQueueStatsRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+// *
+// Represents queue statistics of a game mode
+type QueueStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Mode           string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`                                            // Game mode of queue
+	PlayersQueued  int32                  `protobuf:"varint,2,opt,name=players_queued,json=playersQueued,proto3" json:"players_queued,omitempty"`    // Amount of players waiting in lobbies
+	LobbiesWaiting int32                  `protobuf:"varint,3,opt,name=lobbies_waiting,json=lobbiesWaiting,proto3" json:"lobbies_waiting,omitempty"` // Amount of lobbies waiting for players
+	WaitP50        int32                  `protobuf:"varint,4,opt,name=wait_p50,json=waitP50,proto3" json:"wait_p50,omitempty"`                      // Median seconds of recent lobbies waiting before start
+	WaitP90        int32                  `protobuf:"varint,5,opt,name=wait_p90,json=waitP90,proto3" json:"wait_p90,omitempty"`                      // 90th percentile seconds of recent lobbies waiting before start
+	WaitP99        int32                  `protobuf:"varint,6,opt,name=wait_p99,json=waitP99,proto3" json:"wait_p99,omitempty"`                      // 99th percentile seconds of recent lobbies waiting before start
+	EstimatedWait  int32                  `protobuf:"varint,7,opt,name=estimated_wait,json=estimatedWait,proto3" json:"estimated_wait,omitempty"`    // Estimated seconds before a new player gets into a starting game
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QueueStats) Reset() {
+	*x = QueueStats{}
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueStats) ProtoMessage() {}
+
+func (x *QueueStats) ProtoReflect() protoreflect.Message {
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueStats.ProtoReflect.Descriptor instead.
+func (*QueueStats) Descriptor() ([]byte, []int) {
+	return file_external_lobby_v1_lobby_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueueStats) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *QueueStats) GetPlayersQueued() int32 {
+	if x != nil {
+		return x.PlayersQueued
+	}
+	return 0
+}
+
+func (x *QueueStats) GetLobbiesWaiting() int32 {
+	if x != nil {
+		return x.LobbiesWaiting
+	}
+	return 0
+}
+
+func (x *QueueStats) GetWaitP50() int32 {
+	if x != nil {
+		return x.WaitP50
+	}
+	return 0
+}
+
+func (x *QueueStats) GetWaitP90() int32 {
+	if x != nil {
+		return x.WaitP90
+	}
+	return 0
+}
+
+func (x *QueueStats) GetWaitP99() int32 {
+	if x != nil {
+		return x.WaitP99
+	}
+	return 0
+}
+
+func (x *QueueStats) GetEstimatedWait() int32 {
+	if x != nil {
+		return x.EstimatedWait
+	}
+	return 0
+}
+
+// *
+// Represents a request argument for creating a private lobby
+type CreatePrivateLobbyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`                  // ID of host player
+	Rating        int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`                                     // Rating of host player
+	CategoryIds   []int32                `protobuf:"varint,3,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"` // Categories ids of lobby games
+	Mode          string                 `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`                                          // Game mode of lobby
+	MaxPlayers    int32                  `protobuf:"varint,5,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`           // Amount of maximum possible players in a lobby, by default is maximum of a game mode
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePrivateLobbyRequest) Reset() {
+	*x = CreatePrivateLobbyRequest{}
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePrivateLobbyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePrivateLobbyRequest) ProtoMessage() {}
+
+func (x *CreatePrivateLobbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePrivateLobbyRequest.ProtoReflect.Descriptor instead.
+func (*CreatePrivateLobbyRequest) Descriptor() ([]byte, []int) {
+	return file_external_lobby_v1_lobby_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreatePrivateLobbyRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *CreatePrivateLobbyRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreatePrivateLobbyRequest) GetCategoryIds() []int32 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *CreatePrivateLobbyRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *CreatePrivateLobbyRequest) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+// *
+// Represents a created private lobby
+type PrivateLobby struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LobbyId       string                 `protobuf:"bytes,1,opt,name=lobby_id,json=lobbyId,proto3" json:"lobby_id,omitempty"`           // ID of private lobby
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`                                // Code for joining a private lobby
+	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`                                // Game mode of lobby
+	MaxPlayers    int32                  `protobuf:"varint,4,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"` // Amount of maximum possible players in a lobby
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrivateLobby) Reset() {
+	*x = PrivateLobby{}
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivateLobby) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivateLobby) ProtoMessage() {}
+
+func (x *PrivateLobby) ProtoReflect() protoreflect.Message {
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivateLobby.ProtoReflect.Descriptor instead.
+func (*PrivateLobby) Descriptor() ([]byte, []int) {
+	return file_external_lobby_v1_lobby_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PrivateLobby) GetLobbyId() string {
+	if x != nil {
+		return x.LobbyId
+	}
+	return ""
+}
+
+func (x *PrivateLobby) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PrivateLobby) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *PrivateLobby) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+// *
+// Represents a request argument for joining a private lobby
+type JoinByCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                          // Code of private lobby
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`                  // ID of requester player
+	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`                                     // Rating of a player
+	CategoryIds   []int32                `protobuf:"varint,4,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"` // Desired categories ids
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinByCodeRequest) Reset() {
+	*x = JoinByCodeRequest{}
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinByCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinByCodeRequest) ProtoMessage() {}
+
+func (x *JoinByCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinByCodeRequest.ProtoReflect.Descriptor instead.
+func (*JoinByCodeRequest) Descriptor() ([]byte, []int) {
+	return file_external_lobby_v1_lobby_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *JoinByCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *JoinByCodeRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *JoinByCodeRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *JoinByCodeRequest) GetCategoryIds() []int32 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+// *
+// Represents a request argument for starting a private lobby
+type StartPrivateLobbyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                         // Code of private lobby
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // ID of host player
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartPrivateLobbyRequest) Reset() {
+	*x = StartPrivateLobbyRequest{}
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartPrivateLobbyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPrivateLobbyRequest) ProtoMessage() {}
+
+func (x *StartPrivateLobbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPrivateLobbyRequest.ProtoReflect.Descriptor instead.
+func (*StartPrivateLobbyRequest) Descriptor() ([]byte, []int) {
+	return file_external_lobby_v1_lobby_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StartPrivateLobbyRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *StartPrivateLobbyRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+// *
+// Represents a request argument for resuming a lobby seat
+type ResumeLobbyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // ID of player who resumes a seat
+	LobbyId       string                 `protobuf:"bytes,2,opt,name=lobby_id,json=lobbyId,proto3" json:"lobby_id,omitempty"`    // ID of lobby where player has a seat
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeLobbyRequest) Reset() {
+	*x = ResumeLobbyRequest{}
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeLobbyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeLobbyRequest) ProtoMessage() {}
+
+func (x *ResumeLobbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeLobbyRequest.ProtoReflect.Descriptor instead.
+func (*ResumeLobbyRequest) Descriptor() ([]byte, []int) {
+	return file_external_lobby_v1_lobby_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ResumeLobbyRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *ResumeLobbyRequest) GetLobbyId() string {
+	if x != nil {
+		return x.LobbyId
+	}
+	return ""
+}
+
 // *
 // Represent a stream message with status of request for searching lobby
 type LobbyStatus struct {
@@ -157,13 +824,19 @@ type LobbyStatus struct {
 	MaxPlayers     int32                  `protobuf:"varint,3,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`             // Amount of maximum possible players in a lobby
 	Status         Status                 `protobuf:"varint,4,opt,name=status,proto3,enum=lobbyservice.v1.Status" json:"status,omitempty"`           // Current lobby status
 	GameId         string                 `protobuf:"bytes,5,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                          // If lobby is ready, game_id represents a ID of created game for future request, by default is empty
+	Reason         string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                                        // If lobby is in error status, reason describes what went wrong, by default is empty
+	Teams          []*TeamAssignment      `protobuf:"bytes,7,rep,name=teams,proto3" json:"teams,omitempty"`                                          // If lobby is starting in team mode, teams represents a team of every player, by default is empty
+	RatingWindow   int32                  `protobuf:"varint,8,opt,name=rating_window,json=ratingWindow,proto3" json:"rating_window,omitempty"`       // Current allowed rating difference for lobby players, grows while players are waiting
+	PlayerId       string                 `protobuf:"bytes,9,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`                    // If set, status is addressed only to this player, by default is empty
+	AcceptTimeout  int32                  `protobuf:"varint,10,opt,name=accept_timeout,json=acceptTimeout,proto3" json:"accept_timeout,omitempty"`   // If lobby is in ready check, seconds left for accepting a match, by default is 0
+	EstimatedWait  int32                  `protobuf:"varint,11,opt,name=estimated_wait,json=estimatedWait,proto3" json:"estimated_wait,omitempty"`   // If lobby is waiting, estimated seconds before a game starts, by default is 0
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *LobbyStatus) Reset() {
 	*x = LobbyStatus{}
-	mi := &file_external_lobby_v1_lobby_proto_msgTypes[1]
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -175,7 +848,7 @@ func (x *LobbyStatus) String() string {
 func (*LobbyStatus) ProtoMessage() {}
 
 func (x *LobbyStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_external_lobby_v1_lobby_proto_msgTypes[1]
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -188,7 +861,7 @@ func (x *LobbyStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LobbyStatus.ProtoReflect.Descriptor instead.
 func (*LobbyStatus) Descriptor() ([]byte, []int) {
-	return file_external_lobby_v1_lobby_proto_rawDescGZIP(), []int{1}
+	return file_external_lobby_v1_lobby_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *LobbyStatus) GetLobbyId() string {
@@ -226,48 +899,197 @@ func (x *LobbyStatus) GetGameId() string {
 	return ""
 }
 
+func (x *LobbyStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LobbyStatus) GetTeams() []*TeamAssignment {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *LobbyStatus) GetRatingWindow() int32 {
+	if x != nil {
+		return x.RatingWindow
+	}
+	return 0
+}
+
+func (x *LobbyStatus) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *LobbyStatus) GetAcceptTimeout() int32 {
+	if x != nil {
+		return x.AcceptTimeout
+	}
+	return 0
+}
+
+func (x *LobbyStatus) GetEstimatedWait() int32 {
+	if x != nil {
+		return x.EstimatedWait
+	}
+	return 0
+}
+
+// *
+// Represents a team of a player in team game modes
+type TeamAssignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // ID of player
+	Team          int32                  `protobuf:"varint,2,opt,name=team,proto3" json:"team,omitempty"`                        // Number of player team, starting from 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamAssignment) Reset() {
+	*x = TeamAssignment{}
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamAssignment) ProtoMessage() {}
+
+func (x *TeamAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_external_lobby_v1_lobby_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamAssignment.ProtoReflect.Descriptor instead.
+func (*TeamAssignment) Descriptor() ([]byte, []int) {
+	return file_external_lobby_v1_lobby_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TeamAssignment) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *TeamAssignment) GetTeam() int32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
 var File_external_lobby_v1_lobby_proto protoreflect.FileDescriptor
 
-var file_external_lobby_v1_lobby_proto_rawDesc = string([]byte{
-	0x0a, 0x1d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x6c, 0x6f, 0x62, 0x62, 0x79,
-	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x0f, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
-	0x22, 0x7e, 0x0a, 0x10, 0x4a, 0x6f, 0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52,
-	0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x22, 0xbc, 0x01, 0x0a, 0x0b, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x2a,
-	0x6f, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x57, 0x41, 0x49, 0x54,
-	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x03, 0x12, 0x10,
-	0x0a, 0x0c, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04,
-	0x32, 0x5e, 0x0a, 0x0c, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x4e, 0x0a, 0x09, 0x4a, 0x6f, 0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x21, 0x2e,
-	0x6c, 0x6f, 0x62, 0x62, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4a, 0x6f, 0x69, 0x6e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1c, 0x2e, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x30, 0x01,
-	0x42, 0x12, 0x5a, 0x10, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x62,
-	0x62, 0x79, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-})
+const file_external_lobby_v1_lobby_proto_rawDesc = "" +
+	"\n" +
+	"\x1dexternal/lobby/v1/lobby.proto\x12\x0flobbyservice.v1\x1a\x1bgoogle/protobuf/empty.proto\"\x83\x02\n" +
+	"\x10JoinLobbyRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x05R\x06rating\x12!\n" +
+	"\fcategory_ids\x18\x03 \x03(\x05R\vcategoryIds\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\tR\x04mode\x12\x19\n" +
+	"\bparty_id\x18\x05 \x01(\tR\apartyId\x12A\n" +
+	"\rparty_members\x18\x06 \x03(\v2\x1c.lobbyservice.v1.PartyMemberR\fpartyMembers\x12%\n" +
+	"\x0efallback_modes\x18\a \x03(\tR\rfallbackModes\"e\n" +
+	"\vPartyMember\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x05R\x06rating\x12!\n" +
+	"\fcategory_ids\x18\x03 \x03(\x05R\vcategoryIds\"K\n" +
+	"\x11LeaveLobbyRequest\x12\x19\n" +
+	"\blobby_id\x18\x01 \x01(\tR\alobbyId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"N\n" +
+	"\x14MatchResponseRequest\x12\x19\n" +
+	"\blobby_id\x18\x01 \x01(\tR\alobbyId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"*\n" +
+	"\x14GetQueueStatsRequest\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\"\xe8\x01\n" +
+	"\n" +
+	"QueueStats\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12%\n" +
+	"\x0eplayers_queued\x18\x02 \x01(\x05R\rplayersQueued\x12'\n" +
+	"\x0flobbies_waiting\x18\x03 \x01(\x05R\x0elobbiesWaiting\x12\x19\n" +
+	"\bwait_p50\x18\x04 \x01(\x05R\awaitP50\x12\x19\n" +
+	"\bwait_p90\x18\x05 \x01(\x05R\awaitP90\x12\x19\n" +
+	"\bwait_p99\x18\x06 \x01(\x05R\awaitP99\x12%\n" +
+	"\x0eestimated_wait\x18\a \x01(\x05R\restimatedWait\"\xa8\x01\n" +
+	"\x19CreatePrivateLobbyRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x05R\x06rating\x12!\n" +
+	"\fcategory_ids\x18\x03 \x03(\x05R\vcategoryIds\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\tR\x04mode\x12\x1f\n" +
+	"\vmax_players\x18\x05 \x01(\x05R\n" +
+	"maxPlayers\"r\n" +
+	"\fPrivateLobby\x12\x19\n" +
+	"\blobby_id\x18\x01 \x01(\tR\alobbyId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\x12\x1f\n" +
+	"\vmax_players\x18\x04 \x01(\x05R\n" +
+	"maxPlayers\"\x7f\n" +
+	"\x11JoinByCodeRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12!\n" +
+	"\fcategory_ids\x18\x04 \x03(\x05R\vcategoryIds\"K\n" +
+	"\x18StartPrivateLobbyRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"L\n" +
+	"\x12ResumeLobbyRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
+	"\blobby_id\x18\x02 \x01(\tR\alobbyId\"\x9b\x03\n" +
+	"\vLobbyStatus\x12\x19\n" +
+	"\blobby_id\x18\x01 \x01(\tR\alobbyId\x12'\n" +
+	"\x0fcurrent_players\x18\x02 \x01(\x05R\x0ecurrentPlayers\x12\x1f\n" +
+	"\vmax_players\x18\x03 \x01(\x05R\n" +
+	"maxPlayers\x12/\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x17.lobbyservice.v1.StatusR\x06status\x12\x17\n" +
+	"\agame_id\x18\x05 \x01(\tR\x06gameId\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x125\n" +
+	"\x05teams\x18\a \x03(\v2\x1f.lobbyservice.v1.TeamAssignmentR\x05teams\x12#\n" +
+	"\rrating_window\x18\b \x01(\x05R\fratingWindow\x12\x1b\n" +
+	"\tplayer_id\x18\t \x01(\tR\bplayerId\x12%\n" +
+	"\x0eaccept_timeout\x18\n" +
+	" \x01(\x05R\racceptTimeout\x12%\n" +
+	"\x0eestimated_wait\x18\v \x01(\x05R\restimatedWait\"A\n" +
+	"\x0eTeamAssignment\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x12\n" +
+	"\x04team\x18\x02 \x01(\x05R\x04team*\x9d\x01\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSTATUS_WAITING\x10\x01\x12\x13\n" +
+	"\x0fSTATUS_STARTING\x10\x02\x12\x12\n" +
+	"\x0eSTATUS_TIMEOUT\x10\x03\x12\x10\n" +
+	"\fSTATUS_ERROR\x10\x04\x12\x16\n" +
+	"\x12STATUS_READY_CHECK\x10\x05\x12\x14\n" +
+	"\x10STATUS_RECONNECT\x10\x062\xf9\x05\n" +
+	"\fLobbyService\x12N\n" +
+	"\tJoinLobby\x12!.lobbyservice.v1.JoinLobbyRequest\x1a\x1c.lobbyservice.v1.LobbyStatus0\x01\x12H\n" +
+	"\n" +
+	"LeaveLobby\x12\".lobbyservice.v1.LeaveLobbyRequest\x1a\x16.google.protobuf.Empty\x12L\n" +
+	"\vAcceptMatch\x12%.lobbyservice.v1.MatchResponseRequest\x1a\x16.google.protobuf.Empty\x12M\n" +
+	"\fDeclineMatch\x12%.lobbyservice.v1.MatchResponseRequest\x1a\x16.google.protobuf.Empty\x12S\n" +
+	"\rGetQueueStats\x12%.lobbyservice.v1.GetQueueStatsRequest\x1a\x1b.lobbyservice.v1.QueueStats\x12_\n" +
+	"\x12CreatePrivateLobby\x12*.lobbyservice.v1.CreatePrivateLobbyRequest\x1a\x1d.lobbyservice.v1.PrivateLobby\x12P\n" +
+	"\n" +
+	"JoinByCode\x12\".lobbyservice.v1.JoinByCodeRequest\x1a\x1c.lobbyservice.v1.LobbyStatus0\x01\x12V\n" +
+	"\x11StartPrivateLobby\x12).lobbyservice.v1.StartPrivateLobbyRequest\x1a\x16.google.protobuf.Empty\x12R\n" +
+	"\vResumeLobby\x12#.lobbyservice.v1.ResumeLobbyRequest\x1a\x1c.lobbyservice.v1.LobbyStatus0\x01B\x12Z\x10lobby/v1;lobbyv1b\x06proto3"
 
 var (
 	file_external_lobby_v1_lobby_proto_rawDescOnce sync.Once
@@ -282,21 +1104,51 @@ func file_external_lobby_v1_lobby_proto_rawDescGZIP() []byte {
 }
 
 var file_external_lobby_v1_lobby_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_external_lobby_v1_lobby_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_external_lobby_v1_lobby_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_external_lobby_v1_lobby_proto_goTypes = []any{
-	(Status)(0),              // 0: lobbyservice.v1.Status
-	(*JoinLobbyRequest)(nil), // 1: lobbyservice.v1.JoinLobbyRequest
-	(*LobbyStatus)(nil),      // 2: lobbyservice.v1.LobbyStatus
+	(Status)(0),                       // 0: lobbyservice.v1.Status
+	(*JoinLobbyRequest)(nil),          // 1: lobbyservice.v1.JoinLobbyRequest
+	(*PartyMember)(nil),               // 2: lobbyservice.v1.PartyMember
+	(*LeaveLobbyRequest)(nil),         // 3: lobbyservice.v1.LeaveLobbyRequest
+	(*MatchResponseRequest)(nil),      // 4: lobbyservice.v1.MatchResponseRequest
+	(*GetQueueStatsRequest)(nil),      // 5: lobbyservice.v1.GetQueueStatsRequest
+	(*QueueStats)(nil),                // 6: lobbyservice.v1.QueueStats
+	(*CreatePrivateLobbyRequest)(nil), // 7: lobbyservice.v1.CreatePrivateLobbyRequest
+	(*PrivateLobby)(nil),              // 8: lobbyservice.v1.PrivateLobby
+	(*JoinByCodeRequest)(nil),         // 9: lobbyservice.v1.JoinByCodeRequest
+	(*StartPrivateLobbyRequest)(nil),  // 10: lobbyservice.v1.StartPrivateLobbyRequest
+	(*ResumeLobbyRequest)(nil),        // 11: lobbyservice.v1.ResumeLobbyRequest
+	(*LobbyStatus)(nil),               // 12: lobbyservice.v1.LobbyStatus
+	(*TeamAssignment)(nil),            // 13: lobbyservice.v1.TeamAssignment
+	(*emptypb.Empty)(nil),             // 14: google.protobuf.Empty
 }
 var file_external_lobby_v1_lobby_proto_depIdxs = []int32{
-	0, // 0: lobbyservice.v1.LobbyStatus.status:type_name -> lobbyservice.v1.Status
-	1, // 1: lobbyservice.v1.LobbyService.JoinLobby:input_type -> lobbyservice.v1.JoinLobbyRequest
-	2, // 2: lobbyservice.v1.LobbyService.JoinLobby:output_type -> lobbyservice.v1.LobbyStatus
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2,  // 0: lobbyservice.v1.JoinLobbyRequest.party_members:type_name -> lobbyservice.v1.PartyMember
+	0,  // 1: lobbyservice.v1.LobbyStatus.status:type_name -> lobbyservice.v1.Status
+	13, // 2: lobbyservice.v1.LobbyStatus.teams:type_name -> lobbyservice.v1.TeamAssignment
+	1,  // 3: lobbyservice.v1.LobbyService.JoinLobby:input_type -> lobbyservice.v1.JoinLobbyRequest
+	3,  // 4: lobbyservice.v1.LobbyService.LeaveLobby:input_type -> lobbyservice.v1.LeaveLobbyRequest
+	4,  // 5: lobbyservice.v1.LobbyService.AcceptMatch:input_type -> lobbyservice.v1.MatchResponseRequest
+	4,  // 6: lobbyservice.v1.LobbyService.DeclineMatch:input_type -> lobbyservice.v1.MatchResponseRequest
+	5,  // 7: lobbyservice.v1.LobbyService.GetQueueStats:input_type -> lobbyservice.v1.GetQueueStatsRequest
+	7,  // 8: lobbyservice.v1.LobbyService.CreatePrivateLobby:input_type -> lobbyservice.v1.CreatePrivateLobbyRequest
+	9,  // 9: lobbyservice.v1.LobbyService.JoinByCode:input_type -> lobbyservice.v1.JoinByCodeRequest
+	10, // 10: lobbyservice.v1.LobbyService.StartPrivateLobby:input_type -> lobbyservice.v1.StartPrivateLobbyRequest
+	11, // 11: lobbyservice.v1.LobbyService.ResumeLobby:input_type -> lobbyservice.v1.ResumeLobbyRequest
+	12, // 12: lobbyservice.v1.LobbyService.JoinLobby:output_type -> lobbyservice.v1.LobbyStatus
+	14, // 13: lobbyservice.v1.LobbyService.LeaveLobby:output_type -> google.protobuf.Empty
+	14, // 14: lobbyservice.v1.LobbyService.AcceptMatch:output_type -> google.protobuf.Empty
+	14, // 15: lobbyservice.v1.LobbyService.DeclineMatch:output_type -> google.protobuf.Empty
+	6,  // 16: lobbyservice.v1.LobbyService.GetQueueStats:output_type -> lobbyservice.v1.QueueStats
+	8,  // 17: lobbyservice.v1.LobbyService.CreatePrivateLobby:output_type -> lobbyservice.v1.PrivateLobby
+	12, // 18: lobbyservice.v1.LobbyService.JoinByCode:output_type -> lobbyservice.v1.LobbyStatus
+	14, // 19: lobbyservice.v1.LobbyService.StartPrivateLobby:output_type -> google.protobuf.Empty
+	12, // 20: lobbyservice.v1.LobbyService.ResumeLobby:output_type -> lobbyservice.v1.LobbyStatus
+	12, // [12:21] is the sub-list for method output_type
+	3,  // [3:12] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_external_lobby_v1_lobby_proto_init() }
@@ -310,7 +1162,7 @@ func file_external_lobby_v1_lobby_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_external_lobby_v1_lobby_proto_rawDesc), len(file_external_lobby_v1_lobby_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
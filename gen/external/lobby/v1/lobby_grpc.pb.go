@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,7 +20,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LobbyService_JoinLobby_FullMethodName = "/lobbyservice.v1.LobbyService/JoinLobby"
+	LobbyService_JoinLobby_FullMethodName          = "/lobbyservice.v1.LobbyService/JoinLobby"
+	LobbyService_LeaveLobby_FullMethodName         = "/lobbyservice.v1.LobbyService/LeaveLobby"
+	LobbyService_AcceptMatch_FullMethodName        = "/lobbyservice.v1.LobbyService/AcceptMatch"
+	LobbyService_DeclineMatch_FullMethodName       = "/lobbyservice.v1.LobbyService/DeclineMatch"
+	LobbyService_GetQueueStats_FullMethodName      = "/lobbyservice.v1.LobbyService/GetQueueStats"
+	LobbyService_CreatePrivateLobby_FullMethodName = "/lobbyservice.v1.LobbyService/CreatePrivateLobby"
+	LobbyService_JoinByCode_FullMethodName         = "/lobbyservice.v1.LobbyService/JoinByCode"
+	LobbyService_StartPrivateLobby_FullMethodName  = "/lobbyservice.v1.LobbyService/StartPrivateLobby"
+	LobbyService_ResumeLobby_FullMethodName        = "/lobbyservice.v1.LobbyService/ResumeLobby"
 )
 
 // LobbyServiceClient is the client API for LobbyService service.
@@ -31,6 +40,22 @@ const (
 type LobbyServiceClient interface {
 	// Method for request a game session for online games
 	JoinLobby(ctx context.Context, in *JoinLobbyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LobbyStatus], error)
+	// Method for leaving a lobby before the game starts, client should close its JoinLobby stream afterwards
+	LeaveLobby(ctx context.Context, in *LeaveLobbyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Method for accepting a found match while lobby is in ready check
+	AcceptMatch(ctx context.Context, in *MatchResponseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Method for declining a found match while lobby is in ready check, player is removed from a lobby
+	DeclineMatch(ctx context.Context, in *MatchResponseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Method for getting current queue statistics of a game mode
+	GetQueueStats(ctx context.Context, in *GetQueueStatsRequest, opts ...grpc.CallOption) (*QueueStats, error)
+	// Method for creating a private lobby, host joins it by returned code like other players
+	CreatePrivateLobby(ctx context.Context, in *CreatePrivateLobbyRequest, opts ...grpc.CallOption) (*PrivateLobby, error)
+	// Method for joining a private lobby by its code
+	JoinByCode(ctx context.Context, in *JoinByCodeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LobbyStatus], error)
+	// Method for starting a private lobby game by its host
+	StartPrivateLobby(ctx context.Context, in *StartPrivateLobbyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Method for reattaching a new stream to the player's lobby seat after a dropped stream or STATUS_RECONNECT
+	ResumeLobby(ctx context.Context, in *ResumeLobbyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LobbyStatus], error)
 }
 
 type lobbyServiceClient struct {
@@ -60,6 +85,104 @@ func (c *lobbyServiceClient) JoinLobby(ctx context.Context, in *JoinLobbyRequest
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type LobbyService_JoinLobbyClient = grpc.ServerStreamingClient[LobbyStatus]
 
+func (c *lobbyServiceClient) LeaveLobby(ctx context.Context, in *LeaveLobbyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LobbyService_LeaveLobby_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbyServiceClient) AcceptMatch(ctx context.Context, in *MatchResponseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LobbyService_AcceptMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbyServiceClient) DeclineMatch(ctx context.Context, in *MatchResponseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LobbyService_DeclineMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbyServiceClient) GetQueueStats(ctx context.Context, in *GetQueueStatsRequest, opts ...grpc.CallOption) (*QueueStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueueStats)
+	err := c.cc.Invoke(ctx, LobbyService_GetQueueStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbyServiceClient) CreatePrivateLobby(ctx context.Context, in *CreatePrivateLobbyRequest, opts ...grpc.CallOption) (*PrivateLobby, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrivateLobby)
+	err := c.cc.Invoke(ctx, LobbyService_CreatePrivateLobby_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbyServiceClient) JoinByCode(ctx context.Context, in *JoinByCodeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LobbyStatus], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LobbyService_ServiceDesc.Streams[1], LobbyService_JoinByCode_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[JoinByCodeRequest, LobbyStatus]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LobbyService_JoinByCodeClient = grpc.ServerStreamingClient[LobbyStatus]
+
+func (c *lobbyServiceClient) StartPrivateLobby(ctx context.Context, in *StartPrivateLobbyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LobbyService_StartPrivateLobby_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbyServiceClient) ResumeLobby(ctx context.Context, in *ResumeLobbyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LobbyStatus], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LobbyService_ServiceDesc.Streams[2], LobbyService_ResumeLobby_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ResumeLobbyRequest, LobbyStatus]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LobbyService_ResumeLobbyClient = grpc.ServerStreamingClient[LobbyStatus]
+
 // LobbyServiceServer is the server API for LobbyService service.
 // All implementations should embed UnimplementedLobbyServiceServer
 // for forward compatibility.
@@ -69,6 +192,22 @@ type LobbyService_JoinLobbyClient = grpc.ServerStreamingClient[LobbyStatus]
 type LobbyServiceServer interface {
 	// Method for request a game session for online games
 	JoinLobby(*JoinLobbyRequest, grpc.ServerStreamingServer[LobbyStatus]) error
+	// Method for leaving a lobby before the game starts, client should close its JoinLobby stream afterwards
+	LeaveLobby(context.Context, *LeaveLobbyRequest) (*emptypb.Empty, error)
+	// Method for accepting a found match while lobby is in ready check
+	AcceptMatch(context.Context, *MatchResponseRequest) (*emptypb.Empty, error)
+	// Method for declining a found match while lobby is in ready check, player is removed from a lobby
+	DeclineMatch(context.Context, *MatchResponseRequest) (*emptypb.Empty, error)
+	// Method for getting current queue statistics of a game mode
+	GetQueueStats(context.Context, *GetQueueStatsRequest) (*QueueStats, error)
+	// Method for creating a private lobby, host joins it by returned code like other players
+	CreatePrivateLobby(context.Context, *CreatePrivateLobbyRequest) (*PrivateLobby, error)
+	// Method for joining a private lobby by its code
+	JoinByCode(*JoinByCodeRequest, grpc.ServerStreamingServer[LobbyStatus]) error
+	// Method for starting a private lobby game by its host
+	StartPrivateLobby(context.Context, *StartPrivateLobbyRequest) (*emptypb.Empty, error)
+	// Method for reattaching a new stream to the player's lobby seat after a dropped stream or STATUS_RECONNECT
+	ResumeLobby(*ResumeLobbyRequest, grpc.ServerStreamingServer[LobbyStatus]) error
 }
 
 // UnimplementedLobbyServiceServer should be embedded to have
@@ -81,6 +220,30 @@ type UnimplementedLobbyServiceServer struct{}
 func (UnimplementedLobbyServiceServer) JoinLobby(*JoinLobbyRequest, grpc.ServerStreamingServer[LobbyStatus]) error {
 	return status.Errorf(codes.Unimplemented, "method JoinLobby not implemented")
 }
+func (UnimplementedLobbyServiceServer) LeaveLobby(context.Context, *LeaveLobbyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveLobby not implemented")
+}
+func (UnimplementedLobbyServiceServer) AcceptMatch(context.Context, *MatchResponseRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptMatch not implemented")
+}
+func (UnimplementedLobbyServiceServer) DeclineMatch(context.Context, *MatchResponseRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineMatch not implemented")
+}
+func (UnimplementedLobbyServiceServer) GetQueueStats(context.Context, *GetQueueStatsRequest) (*QueueStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueStats not implemented")
+}
+func (UnimplementedLobbyServiceServer) CreatePrivateLobby(context.Context, *CreatePrivateLobbyRequest) (*PrivateLobby, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePrivateLobby not implemented")
+}
+func (UnimplementedLobbyServiceServer) JoinByCode(*JoinByCodeRequest, grpc.ServerStreamingServer[LobbyStatus]) error {
+	return status.Errorf(codes.Unimplemented, "method JoinByCode not implemented")
+}
+func (UnimplementedLobbyServiceServer) StartPrivateLobby(context.Context, *StartPrivateLobbyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartPrivateLobby not implemented")
+}
+func (UnimplementedLobbyServiceServer) ResumeLobby(*ResumeLobbyRequest, grpc.ServerStreamingServer[LobbyStatus]) error {
+	return status.Errorf(codes.Unimplemented, "method ResumeLobby not implemented")
+}
 func (UnimplementedLobbyServiceServer) testEmbeddedByValue() {}
 
 // UnsafeLobbyServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -112,19 +275,184 @@ func _LobbyService_JoinLobby_Handler(srv interface{}, stream grpc.ServerStream)
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type LobbyService_JoinLobbyServer = grpc.ServerStreamingServer[LobbyStatus]
 
+func _LobbyService_LeaveLobby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveLobbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).LeaveLobby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_LeaveLobby_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).LeaveLobby(ctx, req.(*LeaveLobbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_AcceptMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchResponseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).AcceptMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_AcceptMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).AcceptMatch(ctx, req.(*MatchResponseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_DeclineMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchResponseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).DeclineMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_DeclineMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).DeclineMatch(ctx, req.(*MatchResponseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_GetQueueStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueueStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).GetQueueStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_GetQueueStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).GetQueueStats(ctx, req.(*GetQueueStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_CreatePrivateLobby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePrivateLobbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).CreatePrivateLobby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_CreatePrivateLobby_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).CreatePrivateLobby(ctx, req.(*CreatePrivateLobbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_JoinByCode_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(JoinByCodeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LobbyServiceServer).JoinByCode(m, &grpc.GenericServerStream[JoinByCodeRequest, LobbyStatus]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LobbyService_JoinByCodeServer = grpc.ServerStreamingServer[LobbyStatus]
+
+func _LobbyService_StartPrivateLobby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPrivateLobbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).StartPrivateLobby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_StartPrivateLobby_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).StartPrivateLobby(ctx, req.(*StartPrivateLobbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_ResumeLobby_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResumeLobbyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LobbyServiceServer).ResumeLobby(m, &grpc.GenericServerStream[ResumeLobbyRequest, LobbyStatus]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LobbyService_ResumeLobbyServer = grpc.ServerStreamingServer[LobbyStatus]
+
 // LobbyService_ServiceDesc is the grpc.ServiceDesc for LobbyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var LobbyService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "lobbyservice.v1.LobbyService",
 	HandlerType: (*LobbyServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LeaveLobby",
+			Handler:    _LobbyService_LeaveLobby_Handler,
+		},
+		{
+			MethodName: "AcceptMatch",
+			Handler:    _LobbyService_AcceptMatch_Handler,
+		},
+		{
+			MethodName: "DeclineMatch",
+			Handler:    _LobbyService_DeclineMatch_Handler,
+		},
+		{
+			MethodName: "GetQueueStats",
+			Handler:    _LobbyService_GetQueueStats_Handler,
+		},
+		{
+			MethodName: "CreatePrivateLobby",
+			Handler:    _LobbyService_CreatePrivateLobby_Handler,
+		},
+		{
+			MethodName: "StartPrivateLobby",
+			Handler:    _LobbyService_StartPrivateLobby_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "JoinLobby",
 			Handler:       _LobbyService_JoinLobby_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "JoinByCode",
+			Handler:       _LobbyService_JoinByCode_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ResumeLobby",
+			Handler:       _LobbyService_ResumeLobby_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "external/lobby/v1/lobby.proto",
 }
@@ -0,0 +1,153 @@
+package admin
+
+import (
+	"context"
+	"errors"
+
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	lobbyv1 "github.com/QuizWars-Ecosystem/lobby-service/gen/external/lobby/v1"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/lobby"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/matchmaking"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ lobbyv1.LobbyAdminServiceServer = (*Handler)(nil)
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 500
+)
+
+type Handler struct {
+	store   store.LobbyStore
+	waiter  *lobby.Waiter
+	matcher *matchmaking.Matcher
+	ranking *scorer.Ranking
+	logger  *zap.Logger
+}
+
+func NewHandler(store store.LobbyStore, waiter *lobby.Waiter, matcher *matchmaking.Matcher, ranking *scorer.Ranking, logger *zap.Logger) *Handler {
+	return &Handler{
+		store:   store,
+		waiter:  waiter,
+		matcher: matcher,
+		ranking: ranking,
+		logger:  logger,
+	}
+}
+
+func (h *Handler) ListLobbies(ctx context.Context, request *lobbyv1.ListLobbiesRequest) (*lobbyv1.ListLobbiesResponse, error) {
+	if request.Mode == "" {
+		return nil, apperrors.BadRequest(errors.New("mode is required"))
+	}
+
+	limit := int(request.Limit)
+	switch {
+	case limit <= 0:
+		limit = defaultListLimit
+	case limit > maxListLimit:
+		limit = maxListLimit
+	}
+
+	lobbies, total, err := h.store.ListLobbies(ctx, request.Mode, max(int(request.Offset), 0), limit)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	response := &lobbyv1.ListLobbiesResponse{
+		Lobbies: make([]*lobbyv1.LobbyInfo, 0, len(lobbies)),
+		Total:   int32(total),
+	}
+
+	for _, l := range lobbies {
+		response.Lobbies = append(response.Lobbies, lobbyInfo(l))
+	}
+
+	return response, nil
+}
+
+func (h *Handler) GetLobby(ctx context.Context, request *lobbyv1.GetLobbyRequest) (*lobbyv1.LobbyInfo, error) {
+	l, err := h.store.GetLobby(ctx, request.LobbyId)
+	if err != nil {
+		return nil, lobbyError(request.LobbyId, err)
+	}
+
+	return lobbyInfo(l), nil
+}
+
+func (h *Handler) KickPlayer(ctx context.Context, request *lobbyv1.KickPlayerRequest) (*emptypb.Empty, error) {
+	err := h.waiter.KickPlayer(ctx, request.LobbyId, request.PlayerId, request.Reason)
+	if errors.Is(err, store.ErrPlayerNotInLobby) {
+		return nil, apperrors.NotFound("player", "id", request.PlayerId)
+	}
+
+	if err != nil {
+		return nil, lobbyError(request.LobbyId, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) ForceStartLobby(ctx context.Context, request *lobbyv1.ForceStartLobbyRequest) (*emptypb.Empty, error) {
+	err := h.waiter.ForceStartLobby(ctx, request.LobbyId)
+	if errors.Is(err, lobby.ErrEmptyLobby) {
+		return nil, apperrors.BadRequest(err)
+	}
+
+	if errors.Is(err, lobby.ErrLobbyStarting) {
+		return nil, apperrors.AlreadyExists("lobby start", "id", request.LobbyId)
+	}
+
+	if err != nil {
+		return nil, lobbyError(request.LobbyId, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) CloseLobby(ctx context.Context, request *lobbyv1.CloseLobbyRequest) (*emptypb.Empty, error) {
+	if err := h.waiter.CloseLobby(ctx, request.LobbyId, request.Reason); err != nil {
+		return nil, lobbyError(request.LobbyId, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func lobbyError(lobbyID string, err error) error {
+	if errors.Is(err, store.ErrLobbyNotFound) {
+		return apperrors.NotFound("lobby", "id", lobbyID)
+	}
+
+	return apperrors.Internal(err)
+}
+
+func lobbyInfo(l *models.Lobby) *lobbyv1.LobbyInfo {
+	players := make([]*lobbyv1.LobbyPlayer, 0, len(l.Players))
+	for _, p := range l.Players {
+		players = append(players, &lobbyv1.LobbyPlayer{
+			PlayerId:    p.ID,
+			Rating:      p.Rating,
+			CategoryIds: p.Categories,
+			PartyId:     p.PartyID,
+			JoinedAt:    timestamppb.New(p.JoinedAt),
+		})
+	}
+
+	return &lobbyv1.LobbyInfo{
+		LobbyId:     l.ID,
+		Mode:        l.Mode,
+		Players:     players,
+		CategoryIds: l.Categories,
+		MinPlayers:  int32(l.MinPlayers),
+		MaxPlayers:  int32(l.MaxPlayers),
+		AvgRating:   l.AvgRating,
+		CreatedAt:   timestamppb.New(l.CreatedAt),
+		ExpireAt:    timestamppb.New(l.ExpireAt),
+		ReadyCheck:  l.InReadyCheck(),
+	}
+}
@@ -0,0 +1,96 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	lobbyv1 "github.com/QuizWars-Ecosystem/lobby-service/gen/external/lobby/v1"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/matcher"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func (h *Handler) EvaluateRanking(ctx context.Context, request *lobbyv1.EvaluateRankingRequest) (*lobbyv1.EvaluateRankingResponse, error) {
+	mode, ok := matcher.LookupMode(request.Mode)
+	if !ok {
+		return nil, apperrors.BadRequest(fmt.Errorf("%w: %s", matcher.ErrUnknownMode, request.Mode))
+	}
+
+	formula, ok := h.ranking.Formula(mode.Name)
+	if request.Formula != nil {
+		formula, ok = rankingFormula(request.Formula), true
+	}
+
+	if !ok {
+		return nil, apperrors.BadRequest(fmt.Errorf("formula is required, mode %s uses its built-in ranking", mode.Name))
+	}
+
+	if err := h.ranking.Validate(map[string]scorer.Formula{mode.Name: formula}); err != nil {
+		return nil, apperrors.BadRequest(err)
+	}
+
+	limit := int(request.Limit)
+	switch {
+	case limit <= 0:
+		limit = defaultListLimit
+	case limit > maxListLimit:
+		limit = maxListLimit
+	}
+
+	lobbies, _, err := h.store.ListLobbies(ctx, mode.Name, 0, limit)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	current := h.ranking.Provider(mode.Name, mode.Provider)
+
+	response := &lobbyv1.EvaluateRankingResponse{
+		Formula: rankingFormulaInfo(formula),
+		Lobbies: make([]*lobbyv1.LobbyRanking, 0, len(lobbies)),
+	}
+
+	for _, l := range lobbies {
+		e := formula.Evaluate(l)
+		response.Lobbies = append(response.Lobbies, &lobbyv1.LobbyRanking{
+			LobbyId:      l.ID,
+			Score:        e.Score,
+			CurrentScore: current.CalculateScore(l),
+			Fill:         e.Fill,
+			Diversity:    e.Diversity,
+			Balance:      e.Balance,
+			Wait:         e.Wait,
+		})
+	}
+
+	sort.Slice(response.Lobbies, func(i, j int) bool {
+		return response.Lobbies[i].Score > response.Lobbies[j].Score
+	})
+
+	return response, nil
+}
+
+func rankingFormula(f *lobbyv1.RankingFormula) scorer.Formula {
+	return scorer.Formula{
+		FillWeight:       f.FillWeight,
+		DiversityWeight:  f.DiversityWeight,
+		BalanceWeight:    f.BalanceWeight,
+		WaitWeight:       f.WaitWeight,
+		TargetCategories: int(f.TargetCategories),
+		MaxRatingSpread:  f.MaxRatingSpread,
+		MaxWait:          f.MaxWait.AsDuration(),
+	}
+}
+
+func rankingFormulaInfo(f scorer.Formula) *lobbyv1.RankingFormula {
+	return &lobbyv1.RankingFormula{
+		FillWeight:       f.FillWeight,
+		DiversityWeight:  f.DiversityWeight,
+		BalanceWeight:    f.BalanceWeight,
+		WaitWeight:       f.WaitWeight,
+		TargetCategories: int32(f.TargetCategories),
+		MaxRatingSpread:  f.MaxRatingSpread,
+		MaxWait:          durationpb.New(f.MaxWait),
+	}
+}
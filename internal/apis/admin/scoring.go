@@ -0,0 +1,52 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	lobbyv1 "github.com/QuizWars-Ecosystem/lobby-service/gen/external/lobby/v1"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/matcher"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *Handler) GetScoringConfigs(_ context.Context, request *lobbyv1.GetScoringConfigsRequest) (*lobbyv1.GetScoringConfigsResponse, error) {
+	if request.Mode != "" && !matcher.HasMode(request.Mode) {
+		return nil, apperrors.BadRequest(fmt.Errorf("%w: %s", matcher.ErrUnknownMode, request.Mode))
+	}
+
+	snapshot := h.matcher.Scoring()
+
+	response := &lobbyv1.GetScoringConfigsResponse{
+		Version:   snapshot.Version,
+		AppliedAt: timestamppb.New(snapshot.AppliedAt),
+		Modes:     make([]*lobbyv1.ModeScoringConfig, 0, len(snapshot.Modes)),
+	}
+
+	for _, mode := range snapshot.Modes {
+		if request.Mode != "" && mode.Mode != request.Mode {
+			continue
+		}
+
+		response.Modes = append(response.Modes, &lobbyv1.ModeScoringConfig{
+			Mode:   mode.Mode,
+			Source: mode.Source,
+			Config: scoringConfigInfo(mode.Config),
+		})
+	}
+
+	return response, nil
+}
+
+func scoringConfigInfo(c matcher.ScoringConfig) *lobbyv1.ScoringConfig {
+	return &lobbyv1.ScoringConfig{
+		RatingWeight:          c.RatingWeight,
+		CategoryWeight:        c.CategoryWeight,
+		FillWeight:            c.FillWeight,
+		MaxRatingDiff:         c.MaxRatingDiff,
+		MinCategoryMatchRatio: c.MinCategoryMatch,
+		InitialRatingWindow:   c.InitialRatingWindow,
+		RatingWindowGrowth:    c.RatingWindowGrowth,
+		MaxRatingWindow:       c.MaxRatingWindow,
+	}
+}
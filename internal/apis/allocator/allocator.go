@@ -0,0 +1,37 @@
+package allocator
+
+import (
+	"context"
+	"errors"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+)
+
+var ErrAllocationRejected = errors.New("game allocation rejected")
+
+type GameAllocator interface {
+	Allocate(ctx context.Context, request *AllocateGameRequest) (string, error)
+}
+
+type AllocateGameRequest struct {
+	LobbyID    string           `json:"lobby_id"`
+	Mode       string           `json:"mode"`
+	Categories []int32          `json:"categories"`
+	Players    []*models.Player `json:"players"`
+	Teams      map[string]int32 `json:"teams,omitempty"`
+}
+
+type AllocateGameResponse struct {
+	GameID string `json:"game_id"`
+	Error  string `json:"error,omitempty"`
+}
+
+func NewAllocateGameRequest(lobby *models.Lobby, teams map[string]int32) *AllocateGameRequest {
+	return &AllocateGameRequest{
+		LobbyID:    lobby.ID,
+		Mode:       lobby.Mode,
+		Categories: lobby.Categories,
+		Players:    lobby.Players,
+		Teams:      teams,
+	}
+}
@@ -0,0 +1,38 @@
+package allocator
+
+import "time"
+
+type Config struct {
+	Subject string        `mapstructure:"subject" yaml:"subject" default:"game.allocate"`
+	Timeout time.Duration `mapstructure:"timeout" yaml:"timeout" default:"3s"`
+}
+
+func (a *NATSAllocator) SectionKey() string {
+	return "ALLOCATOR"
+}
+
+func (a *NATSAllocator) UpdateConfig(newCfg *Config) error {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	a.cfg = newCfg
+	return nil
+}
+
+func (a *NATSAllocator) getSubject() string {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+	if a.cfg.Subject == "" {
+		return "game.allocate"
+	}
+	return a.cfg.Subject
+}
+
+func (a *NATSAllocator) getTimeout() time.Duration {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+	if a.cfg.Timeout < time.Millisecond*500 {
+		return time.Millisecond * 500
+	}
+	return a.cfg.Timeout
+}
@@ -0,0 +1,51 @@
+package allocator
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+var _ GameAllocator = (*MemoryAllocator)(nil)
+
+type MemoryAllocator struct {
+	mx          sync.Mutex
+	err         error
+	allocations map[string]*AllocateGameRequest
+}
+
+func NewMemoryAllocator() *MemoryAllocator {
+	return &MemoryAllocator{
+		allocations: make(map[string]*AllocateGameRequest),
+	}
+}
+
+func (a *MemoryAllocator) Allocate(_ context.Context, request *AllocateGameRequest) (string, error) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	if a.err != nil {
+		return "", a.err
+	}
+
+	gameID := uuid.NewString()
+	a.allocations[gameID] = request
+
+	return gameID, nil
+}
+
+func (a *MemoryAllocator) SetError(err error) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	a.err = err
+}
+
+func (a *MemoryAllocator) Allocation(gameID string) (*AllocateGameRequest, bool) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	request, ok := a.allocations[gameID]
+	return request, ok
+}
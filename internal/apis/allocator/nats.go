@@ -0,0 +1,65 @@
+package allocator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+var (
+	_ GameAllocator                          = (*NATSAllocator)(nil)
+	_ abstractions.ConfigSubscriber[*Config] = (*NATSAllocator)(nil)
+)
+
+type NATSAllocator struct {
+	ns     *nats.Conn
+	logger *zap.Logger
+	mx     sync.RWMutex
+	cfg    *Config
+}
+
+func NewNATSAllocator(ns *nats.Conn, logger *zap.Logger, cfg *Config) *NATSAllocator {
+	return &NATSAllocator{
+		ns:     ns,
+		logger: logger,
+		cfg:    cfg,
+	}
+}
+
+func (a *NATSAllocator) Allocate(ctx context.Context, request *AllocateGameRequest) (string, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		a.logger.Error("Failed to marshal allocate game request", zap.String("lobby_id", request.LobbyID), zap.Error(err))
+		return "", err
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, a.getTimeout())
+	defer cancel()
+
+	msg, err := a.ns.RequestWithContext(reqCtx, a.getSubject(), data)
+	if err != nil {
+		a.logger.Warn("Failed to request game allocation", zap.String("lobby_id", request.LobbyID), zap.Error(err))
+		return "", err
+	}
+
+	var response AllocateGameResponse
+	if err = json.Unmarshal(msg.Data, &response); err != nil {
+		a.logger.Error("Failed to unmarshal allocate game response", zap.String("lobby_id", request.LobbyID), zap.Error(err))
+		return "", err
+	}
+
+	if response.Error != "" {
+		return "", fmt.Errorf("%w: %s", ErrAllocationRejected, response.Error)
+	}
+
+	if response.GameID == "" {
+		return "", fmt.Errorf("%w: empty game id", ErrAllocationRejected)
+	}
+
+	return response.GameID, nil
+}
@@ -2,11 +2,19 @@ package handler
 
 import "time"
 
+const (
+	DuplicateJoinReject = "reject"
+	DuplicateJoinMove   = "move"
+)
+
 type Config struct {
-	ModeStats        map[string]StatPair `mapstructure:"mode_stats" yaml:"mode_stats"`
-	LobbyTLL         time.Duration       `mapstructure:"lobby_tll" yaml:"lobby_tll" default:"4m"`
-	MaxLobbyAttempts int                 `mapstructure:"max_lobby_attempts" yaml:"max_lobby_attempts" default:"3"`
-	TopLobbiesLimit  int                 `mapstructure:"top_lobbies_limit" yaml:"top_lobbies_limit" default:"25"`
+	ModeStats         map[string]ModeStat `mapstructure:"mode_stats" yaml:"mode_stats"`
+	LobbyTLL          time.Duration       `mapstructure:"lobby_tll" yaml:"lobby_tll" default:"4m"`
+	MaxLobbyAttempts  int                 `mapstructure:"max_lobby_attempts" yaml:"max_lobby_attempts" default:"3"`
+	TopLobbiesLimit   int                 `mapstructure:"top_lobbies_limit" yaml:"top_lobbies_limit" default:"25"`
+	MigrationInterval time.Duration       `mapstructure:"migration_interval" yaml:"migration_interval" default:"5s"`
+	ResumeGrace       time.Duration       `mapstructure:"resume_grace" yaml:"resume_grace" default:"15s"`
+	DuplicateJoin     string              `mapstructure:"duplicate_join" yaml:"duplicate_join" default:"reject"`
 }
 
 func (h *Handler) SectionKey() string {
@@ -21,18 +29,10 @@ func (h *Handler) UpdateConfig(newCfg *Config) error {
 	return nil
 }
 
-func (h *Handler) getModeStats(mode string) StatPair {
+func (h *Handler) getReadyCheck(mode string) time.Duration {
 	h.mx.RLock()
-	pair, ok := h.cfg.ModeStats[mode]
-	h.mx.RUnlock()
-	if !ok {
-		pair = StatPair{
-			Min: 4,
-			Max: 8,
-		}
-	}
-
-	return pair
+	defer h.mx.RUnlock()
+	return h.cfg.ModeStats[mode].ReadyCheck
 }
 
 func (h *Handler) getLobbyTLL() time.Duration {
@@ -61,3 +61,30 @@ func (h *Handler) getTopLobbiesLimit() int {
 	}
 	return h.cfg.TopLobbiesLimit
 }
+
+func (h *Handler) getMigrationInterval() time.Duration {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+	if h.cfg.MigrationInterval < time.Second {
+		return time.Second
+	}
+	return h.cfg.MigrationInterval
+}
+
+func (h *Handler) getResumeGrace() time.Duration {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+	if h.cfg.ResumeGrace < time.Second {
+		return time.Second
+	}
+	return h.cfg.ResumeGrace
+}
+
+func (h *Handler) getDuplicateJoin() string {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+	if h.cfg.DuplicateJoin != DuplicateJoinMove {
+		return DuplicateJoinReject
+	}
+	return DuplicateJoinMove
+}
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	lobbyv1 "github.com/QuizWars-Ecosystem/lobby-service/gen/external/lobby/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errDraining = status.Error(codes.Unavailable, "lobby service instance is draining")
+
+// Drain rejects new joins and releases every open stream with STATUS_RECONNECT,
+// players keep their seats and reattach to the lobby on another instance.
+func (h *Handler) Drain() {
+	h.drainOnce.Do(func() {
+		close(h.drain)
+	})
+}
+
+func (h *Handler) draining() bool {
+	select {
+	case <-h.drain:
+		return true
+	default:
+		return false
+	}
+}
+
+// sendReconnectStatus ends the player stream with STATUS_RECONNECT, no lobby update follows it.
+func (h *Handler) sendReconnectStatus(stream grpc.ServerStreamingServer[lobbyv1.LobbyStatus], lobbyID, playerID string) {
+	if err := h.streamer.SendLast(lobbyID, playerID, stream, &lobbyv1.LobbyStatus{
+		LobbyId:  lobbyID,
+		PlayerId: playerID,
+		Status:   lobbyv1.Status_STATUS_RECONNECT,
+	}); err != nil {
+		h.logger.Warn("Failed to send reconnect status", zap.String("player_id", playerID), zap.Error(err))
+	}
+}
@@ -0,0 +1,118 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	lobbyv1 "github.com/QuizWars-Ecosystem/lobby-service/gen/external/lobby/v1"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/handler"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/streamer"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+const statusTimeout = time.Second * 5
+
+type testStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *lobbyv1.LobbyStatus
+}
+
+func newTestStream(ctx context.Context) *testStream {
+	return &testStream{ctx: ctx, sent: make(chan *lobbyv1.LobbyStatus, 16)}
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testStream) Send(status *lobbyv1.LobbyStatus) error {
+	s.sent <- status
+	return nil
+}
+
+func (s *testStream) next(t *testing.T) *lobbyv1.LobbyStatus {
+	t.Helper()
+
+	select {
+	case status := <-s.sent:
+		return status
+	case <-time.After(statusTimeout):
+		require.FailNow(t, "no lobby status sent")
+		return nil
+	}
+}
+
+// newTestHandler returns a handler of one instance, instances share the store. Lobby updates
+// are not published without a NATS connection, the handler only sends statuses of its own.
+func newTestHandler(s store.LobbyStore) *handler.Handler {
+	return handler.NewHandler(streamer.NewStreamManager(nil, s, zap.NewNop()), nil, nil, s, nil, zap.NewNop(), &handler.Config{})
+}
+
+func TestDrainKeepsSeatForResume(t *testing.T) {
+	ctx := t.Context()
+	s := store.NewMemoryStore(&scorer.Ranking{})
+
+	player := &models.Player{ID: "player-1", Rating: 1000}
+	l := models.NewLobby("lobby-1", "classic", []*models.Player{player}, time.Minute)
+	l.MinPlayers = 2
+	l.MaxPlayers = 4
+	require.NoError(t, s.AddLobby(ctx, l))
+	require.NoError(t, s.SetSeat(ctx, player.ID, &models.Seat{LobbyID: l.ID, SessionID: "dropped", Detached: true}, time.Minute))
+
+	request := &lobbyv1.ResumeLobbyRequest{LobbyId: l.ID, PlayerId: player.ID}
+
+	draining := newTestHandler(s)
+	drained := newTestStream(ctx)
+	drainedErr := make(chan error, 1)
+
+	go func() {
+		drainedErr <- draining.ResumeLobby(request, drained)
+	}()
+
+	require.Equal(t, lobbyv1.Status_STATUS_WAITING, drained.next(t).Status)
+
+	draining.Drain()
+
+	require.Equal(t, lobbyv1.Status_STATUS_RECONNECT, drained.next(t).Status)
+	require.NoError(t, <-drainedErr)
+
+	seat, err := s.GetSeat(ctx, player.ID)
+	require.NoError(t, err)
+	require.Equal(t, l.ID, seat.LobbyID)
+	require.True(t, seat.Detached)
+
+	resumeCtx, cancel := context.WithCancel(ctx)
+	resumed := newTestStream(resumeCtx)
+	resumedErr := make(chan error, 1)
+
+	go func() {
+		resumedErr <- newTestHandler(s).ResumeLobby(request, resumed)
+	}()
+
+	require.Equal(t, lobbyv1.Status_STATUS_WAITING, resumed.next(t).Status)
+
+	seat, err = s.GetSeat(ctx, player.ID)
+	require.NoError(t, err)
+	require.Equal(t, l.ID, seat.LobbyID)
+	require.False(t, seat.Detached)
+
+	stored, err := s.GetLobby(ctx, l.ID)
+	require.NoError(t, err)
+	require.True(t, stored.HasPlayer(player.ID))
+
+	cancel()
+
+	select {
+	case err = <-resumedErr:
+		require.NoError(t, err)
+	case <-time.After(statusTimeout):
+		require.FailNow(t, "resumed stream did not end")
+	}
+}
@@ -2,9 +2,14 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
 	"time"
 
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	"google.golang.org/protobuf/types/known/emptypb"
+
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/metrics"
 	"github.com/jaevor/go-nanoid"
 	"google.golang.org/grpc/status"
@@ -13,9 +18,11 @@ import (
 	lobbyv1 "github.com/QuizWars-Ecosystem/lobby-service/gen/external/lobby/v1"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/lobby"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/matchmaking"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/stats"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/streamer"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/matcher"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
@@ -24,27 +31,37 @@ var _ lobbyv1.LobbyServiceServer = (*Handler)(nil)
 
 var _ abstractions.ConfigSubscriber[*Config] = (*Handler)(nil)
 
-type StatPair struct {
-	Min int16 `mapstructure:"min"`
-	Max int16 `mapstructure:"max"`
+const (
+	leaveTimeout     = time.Second * 5
+	partyLookupDelay = time.Millisecond * 200
+)
+
+// ModeStat holds handler settings of a mode, player bounds and teams come from the mode registry.
+type ModeStat struct {
+	ReadyCheck time.Duration `mapstructure:"ready_check"`
 }
 
 type Handler struct {
-	streamer   *streamer.StreamManager
-	waiter     *lobby.Waiter
-	matcher    *matchmaking.Matcher
-	store      *store.Store
-	logger     *zap.Logger
-	mx         sync.RWMutex
-	generateId func() string
-	cfg        *Config
+	streamer     *streamer.StreamManager
+	waiter       *lobby.Waiter
+	finder       *matchmaking.Finder
+	store        store.LobbyStore
+	stats        *stats.Collector
+	logger       *zap.Logger
+	mx           sync.RWMutex
+	generateId   func() string
+	generateCode func() string
+	drain        chan struct{}
+	drainOnce    sync.Once
+	cfg          *Config
 }
 
 func NewHandler(
 	streamer *streamer.StreamManager,
 	waiter *lobby.Waiter,
-	matcher *matchmaking.Matcher,
-	store *store.Store,
+	finder *matchmaking.Finder,
+	store store.LobbyStore,
+	stats *stats.Collector,
 	logger *zap.Logger,
 	cfg *Config,
 ) *Handler {
@@ -53,14 +70,22 @@ func NewHandler(
 		logger.Warn("Nanoid canonicalization failed", zap.Error(err))
 	}
 
+	codeFn, err := nanoid.CustomASCII(lobbyCodeAlphabet, 6)
+	if err != nil {
+		logger.Warn("Nanoid code generator initialization failed", zap.Error(err))
+	}
+
 	return &Handler{
-		streamer:   streamer,
-		waiter:     waiter,
-		matcher:    matcher,
-		store:      store,
-		logger:     logger,
-		generateId: fn,
-		cfg:        cfg,
+		streamer:     streamer,
+		waiter:       waiter,
+		finder:       finder,
+		store:        store,
+		stats:        stats,
+		logger:       logger,
+		generateId:   fn,
+		generateCode: codeFn,
+		drain:        make(chan struct{}),
+		cfg:          cfg,
 	}
 }
 
@@ -76,123 +101,418 @@ func (h *Handler) JoinLobby(request *lobbyv1.JoinLobbyRequest, stream grpc.Serve
 		}
 	}()
 
+	if h.draining() {
+		err = errDraining
+		return err
+	}
+
+	sessionID := h.generateId()
+
+	if request.PartyId != "" && len(request.PartyMembers) == 0 {
+		err = h.joinParty(ctx, request, sessionID, stream)
+		return err
+	}
+
+	if err = h.takeSeat(ctx, request.PlayerId, sessionID, ""); err != nil {
+		return err
+	}
+
+	defer h.releaseClaim(request.PlayerId)
+
 	player := &models.Player{
 		ID:         request.PlayerId,
 		Rating:     request.Rating,
 		Categories: request.CategoryIds,
+		PartyID:    request.PartyId,
+		JoinedAt:   time.Now(),
+	}
+
+	members := partyMembers(request)
+	if err = h.takeMemberSeats(ctx, members); err != nil {
+		return err
 	}
 
+	defer func() {
+		for _, member := range members {
+			h.releaseClaim(member.ID)
+		}
+	}()
+
+	players := append([]*models.Player{player}, members...)
 	mode := request.Mode
 
-	var activeLobbies []*models.Lobby
+	m, ok := matcher.LookupMode(mode)
+	if !ok {
+		err = apperrors.BadRequest(fmt.Errorf("%w: %s", matcher.ErrUnknownMode, mode))
+		return err
+	}
+
+	if len(players) > int(m.MaxPlayers) {
+		err = apperrors.BadRequest(fmt.Errorf("party of %d players does not fit into %s lobby", len(players), mode))
+		return err
+	}
+
+	fallbackModes := h.fallbackModes(request, len(players))
+
 	var l *models.Lobby
 
-	activeLobbies, err = h.store.GetTopLobbies(ctx, mode, h.getTopLobbiesLimit())
+	l, err = h.findLobby(ctx, append([]string{mode}, fallbackModes...), players)
 	if err != nil {
 		h.sendErrorStatus(stream, request.PlayerId)
 		return err
 	}
 
-	if len(activeLobbies) != 0 {
-		excludedLobbies := make(map[string]struct{})
+	stopWaiter := func() {}
 
-		for attempt := 0; attempt < h.getMaxLobbyAttempts(); attempt++ {
-			filteredLobbies := make([]*models.Lobby, 0, len(activeLobbies))
-			for _, availableLobby := range activeLobbies {
-				if _, excluded := excludedLobbies[availableLobby.ID]; !excluded {
-					filteredLobbies = append(filteredLobbies, availableLobby)
-				}
-			}
+	if l != nil {
+		h.streamer.RegisterStreamWithSubscription(ctx, l.ID, player.ID, stream)
+		fallbackModes = nil
+	} else {
+		ttl := h.getLobbyTLL()
+
+		newLobby := models.NewLobby(h.generateId(), mode, players, ttl)
 
-			if len(filteredLobbies) == 0 {
+		h.setLobbyBorders(newLobby)
+		attempts := h.getMaxLobbyAttempts()
+
+		for attempt := 0; attempt < attempts; attempt++ {
+			if err = h.store.AddLobby(ctx, newLobby); err == nil {
 				break
 			}
+		}
 
-			candidateLobbies := h.matcher.FilterLobbies(mode, filteredLobbies, player)
-			selectedLobby := h.matcher.SelectBestLobby(mode, candidateLobbies, player)
+		if err != nil {
+			h.logger.Error("Failed to create lobby", zap.Error(err))
+			h.sendErrorStatus(stream, request.PlayerId)
+			return err
+		}
 
-			if selectedLobby == nil {
-				continue
-			}
+		l = newLobby
 
-			if err = h.store.AddPlayer(ctx, selectedLobby.ID, player); err != nil {
-				excludedLobbies[selectedLobby.ID] = struct{}{}
-				continue
-			}
+		stopWaiter = h.waiter.Supervise(l)
 
-			h.streamer.RegisterStreamWithSubscription(ctx, selectedLobby.ID, player.ID, stream)
-			l = selectedLobby
+		h.streamer.RegisterStreamWithSubscription(ctx, l.ID, request.PlayerId, stream)
 
-			metrics.LobbyPlayersCount.WithLabelValues(l.ID, l.Mode).Set(float64(len(l.Players)))
+		h.logger.Debug("Lobby was created",
+			zap.String("lobby_id", l.ID),
+			zap.String("mode", l.Mode),
+		)
+	}
 
-			h.logger.Debug("Lobby was found",
+	if request.PartyId != "" {
+		if err = h.store.SetPartyLobby(ctx, request.PartyId, l.ID, time.Until(l.ExpireAt)); err != nil {
+			h.logger.Warn("Failed to save party lobby",
+				zap.String("party_id", request.PartyId),
 				zap.String("lobby_id", l.ID),
-				zap.String("mode", l.Mode),
+				zap.Error(err),
 			)
+		}
+
+		fallbackModes = nil
+	}
+
+	return h.waitLobby(ctx, l, player, sessionID, stream, fallbackModes, stopWaiter)
+}
 
+func (h *Handler) joinParty(
+	ctx context.Context,
+	request *lobbyv1.JoinLobbyRequest,
+	sessionID string,
+	stream grpc.ServerStreamingServer[lobbyv1.LobbyStatus],
+) error {
+	var (
+		l   *models.Lobby
+		err error
+	)
+
+	for attempt := 0; attempt < h.getMaxLobbyAttempts(); attempt++ {
+		if l, err = h.findPartyLobby(ctx, request.PartyId); err == nil {
 			break
 		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(partyLookupDelay):
+		}
 	}
 
-	if l == nil {
-		ttl := h.getLobbyTLL()
+	if err != nil {
+		return apperrors.NotFound("party", "id", request.PartyId)
+	}
+
+	if !l.HasPlayer(request.PlayerId) {
+		return apperrors.NotFound("party member", "id", request.PlayerId)
+	}
+
+	if err = h.takeSeat(ctx, request.PlayerId, sessionID, l.ID); err != nil {
+		return err
+	}
+
+	h.streamer.RegisterStreamWithSubscription(ctx, l.ID, request.PlayerId, stream)
+
+	h.logger.Debug("Party member joined lobby",
+		zap.String("lobby_id", l.ID),
+		zap.String("party_id", request.PartyId),
+		zap.String("player_id", request.PlayerId),
+	)
 
-		newLobby := &models.Lobby{
-			ID:         h.generateId(),
-			Mode:       mode,
-			Categories: request.CategoryIds,
-			Players:    []*models.Player{player},
-			AvgRating:  player.Rating,
-			CreatedAt:  time.Now(),
-			ExpireAt:   time.Now().Add(ttl),
-			Version:    1,
+	player := &models.Player{ID: request.PlayerId}
+
+	return h.waitLobby(ctx, l, player, sessionID, stream, nil, func() {})
+}
+
+func (h *Handler) findPartyLobby(ctx context.Context, partyID string) (*models.Lobby, error) {
+	lobbyID, err := h.store.GetPartyLobby(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.store.GetLobby(ctx, lobbyID)
+}
+
+func (h *Handler) waitLobby(
+	ctx context.Context,
+	l *models.Lobby,
+	player *models.Player,
+	sessionID string,
+	stream grpc.ServerStreamingServer[lobbyv1.LobbyStatus],
+	fallbackModes []string,
+	stopWaiter context.CancelFunc,
+) error {
+	if !h.holdSeat(ctx, player.ID, sessionID, l) {
+		if err := h.leaveLobby(ctx, l.ID, player.ID); err != nil &&
+			!errors.Is(err, store.ErrLobbyNotFound) && !errors.Is(err, store.ErrPlayerNotInLobby) {
+			h.logger.Warn("Failed to remove superseded player from lobby", zap.String("player_id", player.ID), zap.Error(err))
 		}
 
-		h.setLobbyBorders(newLobby)
-		attempts := h.getMaxLobbyAttempts()
+		return apperrors.AlreadyExists("lobby seat", "player_id", player.ID)
+	}
 
-		for attempt := 0; attempt < attempts; attempt++ {
-			if attempt >= attempts {
-				h.logger.Error("Failed to create lobby", zap.Error(err))
-				h.sendErrorStatus(stream, request.PlayerId)
-				return err
+	metrics.ModePlayersQueued.WithLabelValues(l.Mode).Inc()
+	defer func() {
+		metrics.ModePlayersQueued.WithLabelValues(l.Mode).Dec()
+	}()
+
+	redirects := h.streamer.WatchRedirects(player.ID)
+	defer h.streamer.UnwatchRedirects(player.ID, redirects)
+
+	var migrationTick <-chan time.Time
+	if len(fallbackModes) > 0 {
+		ticker := time.NewTicker(h.getMigrationInterval())
+		defer ticker.Stop()
+
+		migrationTick = ticker.C
+	}
+
+	for done := false; !done; {
+		select {
+		case <-ctx.Done():
+			done = true
+
+		case <-h.drain:
+			h.detachSeat(ctx, player.ID, sessionID)
+			h.sendReconnectStatus(stream, l.ID, player.ID)
+			return nil
+
+		case lobbyID := <-redirects:
+			if lobbyID == "" {
+				if err := h.store.RemoveSeat(ctx, player.ID, l.ID); err != nil {
+					h.logger.Warn("Failed to remove player seat", zap.String("player_id", player.ID), zap.Error(err))
+				}
+
+				h.logger.Debug("Player removed from lobby, closing stream",
+					zap.String("lobby_id", l.ID),
+					zap.String("player_id", player.ID),
+				)
+				return nil
 			}
 
-			if err = h.store.AddLobby(ctx, newLobby); err == nil {
-				break
+			target, err := h.store.GetLobby(ctx, lobbyID)
+			if err != nil {
+				target = &models.Lobby{ID: lobbyID, Mode: l.Mode}
 			}
-		}
 
-		l = newLobby
+			metrics.ModePlayersQueued.WithLabelValues(l.Mode).Dec()
+			metrics.ModePlayersQueued.WithLabelValues(target.Mode).Inc()
 
-		lobbyCtx, cancel := context.WithTimeout(ctx, ttl)
-		defer cancel()
+			l = target
+			migrationTick = nil
 
-		go h.waiter.WaitForLobbyFill(lobbyCtx, l)
+			h.saveSeat(ctx, player.ID, sessionID, l)
 
-		h.streamer.RegisterStream(l.ID, request.PlayerId, stream)
+		case <-migrationTick:
+			target, sourceEmpty, migrateErr := h.migrateLobby(ctx, l, player, fallbackModes)
+			if migrateErr != nil {
+				if err := h.store.RemoveSeat(ctx, player.ID, l.ID); err != nil {
+					h.logger.Warn("Failed to remove player seat", zap.String("player_id", player.ID), zap.Error(err))
+				}
 
-		h.logger.Debug("Lobby was created",
+				h.sendErrorStatus(stream, player.ID)
+				return apperrors.Internal(migrateErr)
+			}
+
+			if target == nil {
+				continue
+			}
+
+			if sourceEmpty {
+				stopWaiter()
+			}
+
+			h.streamer.RegisterStreamWithSubscription(ctx, target.ID, player.ID, stream)
+
+			metrics.ModePlayersQueued.WithLabelValues(l.Mode).Dec()
+			metrics.ModePlayersQueued.WithLabelValues(target.Mode).Inc()
+
+			l = target
+			migrationTick = nil
+
+			h.saveSeat(ctx, player.ID, sessionID, l)
+		}
+	}
+
+	if h.awaitResume(ctx, l.ID, player.ID, sessionID) {
+		h.logger.Debug("Player seat resumed by another stream",
 			zap.String("lobby_id", l.ID),
-			zap.String("mode", l.Mode),
+			zap.String("player_id", player.ID),
 		)
+		return nil
 	}
 
-	metrics.ModePlayersQueued.WithLabelValues(l.Mode).Inc()
-	defer metrics.ModePlayersQueued.WithLabelValues(l.Mode).Dec()
+	leaveCtx, leaveCancel := context.WithTimeout(context.WithoutCancel(ctx), leaveTimeout)
+	defer leaveCancel()
+
+	if leaveErr := h.leaveLobby(leaveCtx, l.ID, player.ID); leaveErr != nil &&
+		!errors.Is(leaveErr, store.ErrLobbyNotFound) && !errors.Is(leaveErr, store.ErrPlayerNotInLobby) {
+		h.logger.Warn("Failed to remove player from lobby after stream end",
+			zap.String("lobby_id", l.ID),
+			zap.String("player_id", player.ID),
+			zap.Error(leaveErr),
+		)
+	}
+
+	return nil
+}
+
+func (h *Handler) LeaveLobby(ctx context.Context, request *lobbyv1.LeaveLobbyRequest) (*emptypb.Empty, error) {
+	err := h.leaveLobby(ctx, request.LobbyId, request.PlayerId)
+
+	switch {
+	case errors.Is(err, store.ErrLobbyNotFound):
+		return nil, apperrors.NotFound("lobby", "id", request.LobbyId)
+	case errors.Is(err, store.ErrPlayerNotInLobby):
+		return nil, apperrors.NotFound("player", "id", request.PlayerId)
+	case err != nil:
+		return nil, apperrors.Internal(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) AcceptMatch(ctx context.Context, request *lobbyv1.MatchResponseRequest) (*emptypb.Empty, error) {
+	if err := h.waiter.AcceptMatch(ctx, request.LobbyId, request.PlayerId); err != nil {
+		return nil, matchResponseError(request, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) DeclineMatch(ctx context.Context, request *lobbyv1.MatchResponseRequest) (*emptypb.Empty, error) {
+	if err := h.waiter.DeclineMatch(ctx, request.LobbyId, request.PlayerId); err != nil {
+		return nil, matchResponseError(request, err)
+	}
+
+	h.logger.Debug("Player declined match",
+		zap.String("lobby_id", request.LobbyId),
+		zap.String("player_id", request.PlayerId),
+	)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) GetQueueStats(ctx context.Context, request *lobbyv1.GetQueueStatsRequest) (*lobbyv1.QueueStats, error) {
+	if request.Mode == "" {
+		return nil, apperrors.BadRequest(errors.New("mode is required"))
+	}
+
+	queueStats, err := h.stats.Stats(ctx, request.Mode)
+	if err != nil {
+		return nil, apperrors.Internal(err)
+	}
+
+	return &lobbyv1.QueueStats{
+		Mode:           queueStats.Mode,
+		PlayersQueued:  int32(queueStats.PlayersQueued),
+		LobbiesWaiting: int32(queueStats.LobbiesWaiting),
+		WaitP50:        int32(queueStats.WaitP50.Seconds()),
+		WaitP90:        int32(queueStats.WaitP90.Seconds()),
+		WaitP99:        int32(queueStats.WaitP99.Seconds()),
+		EstimatedWait:  int32(queueStats.EstimateWait(0).Seconds()),
+	}, nil
+}
+
+func matchResponseError(request *lobbyv1.MatchResponseRequest, err error) error {
+	switch {
+	case errors.Is(err, store.ErrLobbyNotFound):
+		return apperrors.NotFound("lobby", "id", request.LobbyId)
+	case errors.Is(err, store.ErrPlayerNotInLobby):
+		return apperrors.NotFound("player", "id", request.PlayerId)
+	case errors.Is(err, lobby.ErrNotInReadyCheck):
+		return apperrors.BadRequest(err)
+	default:
+		return apperrors.Internal(err)
+	}
+}
+
+func (h *Handler) leaveLobby(ctx context.Context, lobbyID, playerID string) error {
+	l, err := h.store.RemovePlayer(ctx, lobbyID, playerID)
+	if err != nil {
+		return err
+	}
+
+	h.streamer.UnregisterStream(lobbyID, playerID)
+
+	metrics.LobbyPlayersCount.WithLabelValues(l.ID, l.Mode).Set(float64(len(l.Players)))
+
+	h.logger.Debug("Player left lobby",
+		zap.String("lobby_id", l.ID),
+		zap.String("player_id", playerID),
+	)
 
-	<-ctx.Done()
 	return nil
 }
 
+func partyMembers(request *lobbyv1.JoinLobbyRequest) []*models.Player {
+	members := make([]*models.Player, 0, len(request.PartyMembers))
+
+	for _, member := range request.PartyMembers {
+		if member.PlayerId == request.PlayerId {
+			continue
+		}
+
+		members = append(members, &models.Player{
+			ID:         member.PlayerId,
+			Rating:     member.Rating,
+			Categories: member.CategoryIds,
+			PartyID:    request.PartyId,
+			JoinedAt:   time.Now(),
+		})
+	}
+
+	return members
+}
+
 func (h *Handler) setLobbyBorders(lobby *models.Lobby) {
-	pair := h.getModeStats(lobby.Mode)
-	lobby.MinPlayers = pair.Min
-	lobby.MaxPlayers = pair.Max
+	m, _ := matcher.LookupMode(lobby.Mode)
+	lobby.MinPlayers = m.MinPlayers
+	lobby.MaxPlayers = m.MaxPlayers
+	lobby.Teams = m.Teams
+	lobby.ReadyCheck = h.getReadyCheck(lobby.Mode)
 }
 
 func (h *Handler) sendErrorStatus(stream grpc.ServerStreamingServer[lobbyv1.LobbyStatus], playerID string) {
-	if sendErr := stream.Send(&lobbyv1.LobbyStatus{
+	if sendErr := h.streamer.Send(stream, &lobbyv1.LobbyStatus{
 		Status: lobbyv1.Status_STATUS_ERROR,
 	}); sendErr != nil {
 		h.logger.Warn("Failed to send error status", zap.String("player_id", playerID), zap.Error(sendErr))
@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	lobbyv1 "github.com/QuizWars-Ecosystem/lobby-service/gen/external/lobby/v1"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/lobby"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/metrics"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/matcher"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const lobbyCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+func (h *Handler) CreatePrivateLobby(ctx context.Context, request *lobbyv1.CreatePrivateLobbyRequest) (*lobbyv1.PrivateLobby, error) {
+	if h.draining() {
+		return nil, errDraining
+	}
+
+	m, ok := matcher.LookupMode(request.Mode)
+	if !ok {
+		return nil, apperrors.BadRequest(fmt.Errorf("%w: %s", matcher.ErrUnknownMode, request.Mode))
+	}
+
+	maxPlayers := int16(request.MaxPlayers)
+	if maxPlayers == 0 {
+		maxPlayers = m.MaxPlayers
+	}
+
+	if maxPlayers < m.MinPlayers || maxPlayers > m.MaxPlayers {
+		return nil, apperrors.BadRequest(fmt.Errorf("max players of %s lobby must be between %d and %d", request.Mode, m.MinPlayers, m.MaxPlayers))
+	}
+
+	host := &models.Player{
+		ID:         request.PlayerId,
+		Rating:     request.Rating,
+		Categories: request.CategoryIds,
+		JoinedAt:   time.Now(),
+	}
+
+	l := models.NewPrivateLobby(h.generateId(), h.generateCode(), request.Mode, host, h.getLobbyTLL())
+	h.setLobbyBorders(l)
+	l.MaxPlayers = maxPlayers
+
+	// The host is seated without a stream, JoinByCode attaches it later.
+	if err := h.takeSeat(ctx, host.ID, "", l.ID); err != nil {
+		return nil, err
+	}
+
+	var err error
+
+	for attempt := 0; attempt < h.getMaxLobbyAttempts(); attempt++ {
+		if attempt > 0 {
+			l.Code = h.generateCode()
+		}
+
+		if err = h.store.AddPrivateLobby(ctx, l); err == nil {
+			break
+		}
+	}
+
+	if err != nil {
+		h.logger.Error("Failed to create private lobby", zap.Error(err))
+		h.releaseSeat(host.ID, l.ID)
+		return nil, apperrors.Internal(err)
+	}
+
+	h.waiter.Supervise(l)
+
+	h.logger.Debug("Private lobby was created",
+		zap.String("lobby_id", l.ID),
+		zap.String("mode", l.Mode),
+		zap.String("host_id", l.HostID),
+	)
+
+	return &lobbyv1.PrivateLobby{
+		LobbyId:    l.ID,
+		Code:       l.Code,
+		Mode:       l.Mode,
+		MaxPlayers: int32(l.MaxPlayers),
+	}, nil
+}
+
+func (h *Handler) JoinByCode(request *lobbyv1.JoinByCodeRequest, stream grpc.ServerStreamingServer[lobbyv1.LobbyStatus]) error {
+	ctx := stream.Context()
+	var err error
+
+	metrics.ActiveGRPCStreams.Inc()
+	defer func() {
+		defer metrics.ActiveGRPCStreams.Dec()
+		if err != nil {
+			metrics.GRPCStreamErrors.WithLabelValues(status.Code(err).String()).Inc()
+		}
+	}()
+
+	if h.draining() {
+		err = errDraining
+		return err
+	}
+
+	sessionID := h.generateId()
+
+	var l *models.Lobby
+
+	l, err = h.store.GetLobbyByCode(ctx, request.Code)
+	if errors.Is(err, store.ErrLobbyNotFound) {
+		err = apperrors.NotFound("lobby", "code", request.Code)
+		return err
+	}
+
+	if err != nil {
+		err = apperrors.Internal(err)
+		return err
+	}
+
+	if err = h.takeSeat(ctx, request.PlayerId, sessionID, l.ID); err != nil {
+		return err
+	}
+
+	player := &models.Player{
+		ID:         request.PlayerId,
+		Rating:     request.Rating,
+		Categories: request.CategoryIds,
+		JoinedAt:   time.Now(),
+	}
+
+	if !l.HasPlayer(player.ID) {
+		if err = h.store.AddPlayers(ctx, l.ID, player); err != nil {
+			h.releaseSeat(player.ID, l.ID)
+		}
+
+		switch {
+		case errors.Is(err, store.ErrLobbyFull), errors.Is(err, store.ErrLobbyStarting):
+			err = apperrors.BadRequest(err)
+			return err
+		case err != nil:
+			err = apperrors.Internal(err)
+			return err
+		}
+	}
+
+	h.streamer.RegisterStreamWithSubscription(ctx, l.ID, player.ID, stream)
+
+	h.logger.Debug("Player joined private lobby",
+		zap.String("lobby_id", l.ID),
+		zap.String("player_id", player.ID),
+	)
+
+	return h.waitLobby(ctx, l, player, sessionID, stream, nil, func() {})
+}
+
+func (h *Handler) StartPrivateLobby(ctx context.Context, request *lobbyv1.StartPrivateLobbyRequest) (*emptypb.Empty, error) {
+	l, err := h.store.GetLobbyByCode(ctx, request.Code)
+	if err == nil {
+		err = h.waiter.StartPrivateLobby(ctx, l.ID, request.PlayerId)
+	}
+
+	switch {
+	case errors.Is(err, store.ErrLobbyNotFound):
+		return nil, apperrors.NotFound("lobby", "code", request.Code)
+	case errors.Is(err, lobby.ErrNotLobbyHost):
+		return nil, apperrors.Forbidden(err.Error())
+	case errors.Is(err, lobby.ErrNotEnoughPlayers):
+		return nil, apperrors.BadRequest(err)
+	case errors.Is(err, lobby.ErrLobbyStarting):
+		return nil, apperrors.AlreadyExists("lobby start", "code", request.Code)
+	case err != nil:
+		return nil, apperrors.Internal(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
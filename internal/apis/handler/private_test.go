@@ -0,0 +1,28 @@
+package handler_test
+
+import (
+	"testing"
+	"time"
+
+	lobbyv1 "github.com/QuizWars-Ecosystem/lobby-service/gen/external/lobby/v1"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJoinFullPrivateLobbyReleasesSeat(t *testing.T) {
+	ctx := t.Context()
+	s := store.NewMemoryStore(&scorer.Ranking{})
+
+	l := models.NewPrivateLobby("lobby-1", "ABCDEF", "classic", &models.Player{ID: "host"}, time.Minute)
+	l.MinPlayers = 1
+	l.MaxPlayers = 1
+	require.NoError(t, s.AddPrivateLobby(ctx, l))
+
+	err := newTestHandler(s).JoinByCode(&lobbyv1.JoinByCodeRequest{Code: l.Code, PlayerId: "player-1"}, newTestStream(ctx))
+	require.Error(t, err)
+
+	_, err = s.GetSeat(ctx, "player-1")
+	require.ErrorIs(t, err, store.ErrSeatNotFound)
+}
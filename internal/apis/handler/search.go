@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	lobbyv1 "github.com/QuizWars-Ecosystem/lobby-service/gen/external/lobby/v1"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/matchmaking"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/matcher"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) findLobby(ctx context.Context, modes []string, players []*models.Player, excludedIDs ...string) (*models.Lobby, error) {
+	return h.finder.FindLobby(ctx, modes, players, matchmaking.SearchOptions{
+		Limit:       h.getTopLobbiesLimit(),
+		Attempts:    h.getMaxLobbyAttempts(),
+		ExcludedIDs: excludedIDs,
+	})
+}
+
+var errNotLonePlayer = errors.New("player is no longer alone in lobby")
+
+// migrateLobby moves a player waiting alone in source to a lobby of a fallback mode. The player
+// leaves source first, while still its only player, so it is never listed in two lobbies, and
+// is put back when no target is found. An error means the player could not be put back.
+func (h *Handler) migrateLobby(
+	ctx context.Context,
+	source *models.Lobby,
+	player *models.Player,
+	modes []string,
+) (*models.Lobby, bool, error) {
+	_, err := h.store.UpdateLobby(ctx, source.ID, func(lobby *models.Lobby) error {
+		if len(lobby.Players) != 1 || !lobby.HasPlayer(player.ID) {
+			return errNotLonePlayer
+		}
+
+		lobby.RemovePlayer(player.ID)
+
+		return nil
+	})
+	if err != nil {
+		return nil, false, nil
+	}
+
+	target, err := h.findLobby(ctx, modes, []*models.Player{player}, source.ID)
+	if err != nil || target == nil {
+		if err = h.store.AddPlayer(ctx, source.ID, player); err != nil {
+			h.logger.Error("Failed to put player back into source lobby",
+				zap.String("lobby_id", source.ID),
+				zap.String("player_id", player.ID),
+				zap.Error(err),
+			)
+			return nil, false, err
+		}
+
+		return nil, false, nil
+	}
+
+	h.streamer.UnregisterStream(source.ID, player.ID)
+
+	// Another player may have joined source since the player left it.
+	remaining, err := h.store.GetLobby(ctx, source.ID)
+	sourceEmpty := err == nil && len(remaining.Players) == 0
+	if sourceEmpty {
+		if err = h.store.RemoveLobby(ctx, source.ID, source.Mode); err != nil {
+			h.logger.Warn("Failed to remove migrated lobby", zap.String("lobby_id", source.ID), zap.Error(err))
+		}
+	}
+
+	h.logger.Debug("Player migrated to lobby of fallback mode",
+		zap.String("from_lobby_id", source.ID),
+		zap.String("to_lobby_id", target.ID),
+		zap.String("mode", target.Mode),
+	)
+
+	return target, sourceEmpty, nil
+}
+
+func (h *Handler) fallbackModes(request *lobbyv1.JoinLobbyRequest, partySize int) []string {
+	seen := map[string]struct{}{request.Mode: {}}
+	modes := make([]string, 0, len(request.FallbackModes))
+
+	for _, mode := range request.FallbackModes {
+		if _, ok := seen[mode]; ok || mode == "" {
+			continue
+		}
+		seen[mode] = struct{}{}
+
+		if m, ok := matcher.LookupMode(mode); !ok || partySize > int(m.MaxPlayers) {
+			continue
+		}
+
+		modes = append(modes, mode)
+	}
+
+	return modes
+}
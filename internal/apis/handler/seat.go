@@ -0,0 +1,285 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apperrors "github.com/QuizWars-Ecosystem/go-common/pkg/error"
+	lobbyv1 "github.com/QuizWars-Ecosystem/lobby-service/gen/external/lobby/v1"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/metrics"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	seatClaimTTL      = time.Second * 30
+	seatUpdateTimeout = time.Second * 2
+	resumePollDelay   = time.Millisecond * 500
+)
+
+func (h *Handler) ResumeLobby(request *lobbyv1.ResumeLobbyRequest, stream grpc.ServerStreamingServer[lobbyv1.LobbyStatus]) error {
+	ctx := stream.Context()
+	var err error
+
+	metrics.ActiveGRPCStreams.Inc()
+	defer func() {
+		defer metrics.ActiveGRPCStreams.Dec()
+		if err != nil {
+			metrics.GRPCStreamErrors.WithLabelValues(status.Code(err).String()).Inc()
+		}
+	}()
+
+	if h.draining() {
+		err = errDraining
+		return err
+	}
+
+	var l *models.Lobby
+
+	l, err = h.store.GetLobby(ctx, request.LobbyId)
+	if errors.Is(err, store.ErrLobbyNotFound) {
+		err = apperrors.NotFound("lobby", "id", request.LobbyId)
+		return err
+	}
+
+	if err != nil {
+		err = apperrors.Internal(err)
+		return err
+	}
+
+	if !l.HasPlayer(request.PlayerId) {
+		err = apperrors.NotFound("player", "id", request.PlayerId)
+		return err
+	}
+
+	sessionID := h.generateId()
+	player := &models.Player{ID: request.PlayerId}
+
+	// Only a seat whose stream dropped is handed over, so concurrent resumes get one winner.
+	var resumed bool
+
+	seat := &models.Seat{LobbyID: l.ID, SessionID: sessionID}
+	resumed, err = h.store.ResumeSeat(ctx, player.ID, seat, time.Until(l.ExpireAt)+h.getResumeGrace())
+	if err != nil {
+		err = apperrors.Internal(err)
+		return err
+	}
+
+	if !resumed {
+		err = apperrors.AlreadyExists("lobby seat", "player_id", player.ID)
+		return err
+	}
+
+	h.streamer.RegisterStreamWithSubscription(ctx, l.ID, player.ID, stream)
+
+	current := lobbyv1.Status_STATUS_WAITING
+	if l.InReadyCheck() {
+		current = lobbyv1.Status_STATUS_READY_CHECK
+	}
+
+	if err = h.streamer.Send(stream, &lobbyv1.LobbyStatus{
+		LobbyId:        l.ID,
+		PlayerId:       player.ID,
+		Status:         current,
+		CurrentPlayers: int32(len(l.Players)),
+		MaxPlayers:     int32(l.MaxPlayers),
+	}); err != nil {
+		return err
+	}
+
+	h.logger.Debug("Player resumed lobby seat",
+		zap.String("lobby_id", l.ID),
+		zap.String("player_id", player.ID),
+	)
+
+	return h.waitLobby(ctx, l, player, sessionID, stream, nil, func() {})
+}
+
+// takeSeat attaches a new stream session to the player seat, lobbyID is empty
+// while the stream still searches for a lobby. A player seated by another stream
+// is rejected or moved over to this one depending on the duplicate join policy.
+func (h *Handler) takeSeat(ctx context.Context, playerID, sessionID, lobbyID string) error {
+	seat := &models.Seat{LobbyID: lobbyID, SessionID: sessionID}
+
+	claimed, err := h.store.ClaimSeat(ctx, playerID, seat, seatClaimTTL)
+	if err != nil {
+		return apperrors.Internal(err)
+	}
+
+	if claimed {
+		return nil
+	}
+
+	current, err := h.store.GetSeat(ctx, playerID)
+	switch {
+	case errors.Is(err, store.ErrSeatNotFound):
+	case err != nil:
+		return apperrors.Internal(err)
+	case lobbyID != "" && current.SessionID == "" && current.LobbyID == lobbyID:
+		// Seat reserved by a party leader, the member attaches its first stream.
+	case h.seatTaken(ctx, playerID, current):
+		if h.getDuplicateJoin() != DuplicateJoinMove {
+			return apperrors.AlreadyExists("lobby seat", "player_id", playerID)
+		}
+
+		if current.LobbyID != lobbyID {
+			h.evictSeat(ctx, playerID, current.LobbyID)
+		}
+	}
+
+	if err = h.store.SetSeat(ctx, playerID, seat, seatClaimTTL); err != nil {
+		return apperrors.Internal(err)
+	}
+
+	return nil
+}
+
+// takeMemberSeats claims seats of party members joining through their leader, they stay
+// without a session until each member attaches its own stream. A member seated elsewhere
+// fails the whole party or is moved over depending on the duplicate join policy.
+func (h *Handler) takeMemberSeats(ctx context.Context, members []*models.Player) error {
+	for i, member := range members {
+		if err := h.takeSeat(ctx, member.ID, "", ""); err != nil {
+			for _, claimed := range members[:i] {
+				h.releaseClaim(claimed.ID)
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// evictSeat frees the older seat of a player moved to a newer join request.
+func (h *Handler) evictSeat(ctx context.Context, playerID, lobbyID string) {
+	if lobbyID == "" {
+		return
+	}
+
+	if _, err := h.store.RemovePlayer(ctx, lobbyID, playerID); err != nil {
+		if !errors.Is(err, store.ErrLobbyNotFound) && !errors.Is(err, store.ErrPlayerNotInLobby) {
+			h.logger.Warn("Failed to move player from older lobby",
+				zap.String("lobby_id", lobbyID),
+				zap.String("player_id", playerID),
+				zap.Error(err),
+			)
+		}
+		return
+	}
+
+	if err := h.streamer.PublishLobbyStatus(lobbyID, &lobbyv1.LobbyStatus{
+		LobbyId:  lobbyID,
+		PlayerId: playerID,
+		Status:   lobbyv1.Status_STATUS_ERROR,
+		Reason:   "moved to a newer join request",
+	}); err != nil {
+		h.logger.Warn("Failed to notify moved player", zap.String("player_id", playerID), zap.Error(err))
+	}
+
+	metrics.LobbyStatusChanges.WithLabelValues("player_moved").Inc()
+}
+
+func (h *Handler) seatTaken(ctx context.Context, playerID string, seat *models.Seat) bool {
+	if seat.LobbyID == "" {
+		return true
+	}
+
+	l, err := h.store.GetLobby(ctx, seat.LobbyID)
+
+	return err == nil && l.HasPlayer(playerID)
+}
+
+// holdSeat refreshes the seat for lobby l, false means a newer join took the player over.
+func (h *Handler) holdSeat(ctx context.Context, playerID, sessionID string, l *models.Lobby) bool {
+	seat, err := h.store.GetSeat(ctx, playerID)
+	if err == nil && seat.SessionID != sessionID {
+		return false
+	}
+
+	h.saveSeat(ctx, playerID, sessionID, l)
+
+	return true
+}
+
+func (h *Handler) saveSeat(ctx context.Context, playerID, sessionID string, l *models.Lobby) {
+	seat := &models.Seat{LobbyID: l.ID, SessionID: sessionID}
+
+	if err := h.store.SetSeat(ctx, playerID, seat, time.Until(l.ExpireAt)+h.getResumeGrace()); err != nil {
+		h.logger.Warn("Failed to save player seat",
+			zap.String("lobby_id", l.ID),
+			zap.String("player_id", playerID),
+			zap.Error(err),
+		)
+	}
+}
+
+// releaseClaim drops a claim which never got a lobby, seated players are left untouched.
+func (h *Handler) releaseClaim(playerID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), seatUpdateTimeout)
+	defer cancel()
+
+	if err := h.store.RemoveSeat(ctx, playerID, ""); err != nil {
+		h.logger.Warn("Failed to release player seat claim", zap.String("player_id", playerID), zap.Error(err))
+	}
+}
+
+// releaseSeat drops the seat of a lobby which was never created or never took the player in.
+func (h *Handler) releaseSeat(playerID, lobbyID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), seatUpdateTimeout)
+	defer cancel()
+
+	if err := h.store.RemoveSeat(ctx, playerID, lobbyID); err != nil {
+		h.logger.Warn("Failed to release player seat", zap.String("player_id", playerID), zap.Error(err))
+	}
+}
+
+// detachSeat hands the seat of a stream ending without the player leaving over to the next
+// ResumeLobby, it is not bound to the stream context which may already be cancelled.
+func (h *Handler) detachSeat(ctx context.Context, playerID, sessionID string) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), seatUpdateTimeout)
+	defer cancel()
+
+	if err := h.store.DetachSeat(ctx, playerID, sessionID); err != nil {
+		h.logger.Warn("Failed to detach player seat", zap.String("player_id", playerID), zap.Error(err))
+	}
+}
+
+// awaitResume keeps the seat of a dropped stream for the grace period,
+// true means the player resumed it with a new stream.
+func (h *Handler) awaitResume(ctx context.Context, lobbyID, playerID, sessionID string) bool {
+	graceCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), h.getResumeGrace())
+	defer cancel()
+
+	if err := h.store.DetachSeat(graceCtx, playerID, sessionID); err != nil {
+		h.logger.Warn("Failed to detach player seat", zap.String("player_id", playerID), zap.Error(err))
+	}
+
+	ticker := time.NewTicker(resumePollDelay)
+	defer ticker.Stop()
+
+	for {
+		seat, err := h.store.GetSeat(graceCtx, playerID)
+		switch {
+		case errors.Is(err, store.ErrSeatNotFound):
+			return false
+		case err == nil && seat.SessionID != sessionID:
+			return true
+		}
+
+		l, err := h.store.GetLobby(graceCtx, lobbyID)
+		if errors.Is(err, store.ErrLobbyNotFound) || (err == nil && !l.HasPlayer(playerID)) {
+			return false
+		}
+
+		select {
+		case <-graceCtx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+}
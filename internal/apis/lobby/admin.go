@@ -0,0 +1,98 @@
+package lobby
+
+import (
+	"context"
+	"errors"
+
+	lobbyv1 "github.com/QuizWars-Ecosystem/lobby-service/gen/external/lobby/v1"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/metrics"
+	"go.uber.org/zap"
+)
+
+var ErrEmptyLobby = errors.New("lobby has no players")
+
+const (
+	reasonPlayerKicked = "kicked from lobby"
+	reasonLobbyClosed  = "lobby closed"
+)
+
+func (w *Waiter) KickPlayer(ctx context.Context, lobbyID, playerID, reason string) error {
+	lobby, err := w.store.RemovePlayer(ctx, lobbyID, playerID)
+	if err != nil {
+		return err
+	}
+
+	if reason == "" {
+		reason = reasonPlayerKicked
+	}
+
+	defer metrics.LobbyStatusChanges.WithLabelValues("kicked").Inc()
+
+	metrics.LobbyPlayersCount.WithLabelValues(lobby.ID, lobby.Mode).Set(float64(len(lobby.Players)))
+
+	w.broadcastStatus(lobby.ID, &lobbyv1.LobbyStatus{
+		LobbyId:        lobby.ID,
+		Status:         lobbyv1.Status_STATUS_ERROR,
+		CurrentPlayers: int32(len(lobby.Players)),
+		MaxPlayers:     int32(lobby.MaxPlayers),
+		Reason:         reason,
+		PlayerId:       playerID,
+	})
+
+	w.logger.Info("Player kicked from lobby",
+		zap.String("lobby_id", lobby.ID),
+		zap.String("player_id", playerID),
+		zap.String("reason", reason))
+
+	return nil
+}
+
+func (w *Waiter) ForceStartLobby(ctx context.Context, lobbyID string) error {
+	lobby, err := w.store.GetLobby(ctx, lobbyID)
+	if err != nil {
+		return err
+	}
+
+	if len(lobby.Players) == 0 {
+		return ErrEmptyLobby
+	}
+
+	if err = w.startLobby(ctx, lobby); err != nil {
+		return err
+	}
+
+	w.logger.Info("Lobby force started",
+		zap.String("lobby_id", lobby.ID),
+		zap.Int("players", len(lobby.Players)))
+
+	return nil
+}
+
+func (w *Waiter) CloseLobby(ctx context.Context, lobbyID, reason string) error {
+	lobby, err := w.store.GetLobby(ctx, lobbyID)
+	if err != nil {
+		return err
+	}
+
+	if reason == "" {
+		reason = reasonLobbyClosed
+	}
+
+	defer metrics.LobbyStatusChanges.WithLabelValues("closed").Inc()
+
+	w.removeLobby(ctx, lobby, "closed")
+
+	w.broadcastStatus(lobby.ID, &lobbyv1.LobbyStatus{
+		LobbyId:        lobby.ID,
+		Status:         lobbyv1.Status_STATUS_ERROR,
+		CurrentPlayers: int32(len(lobby.Players)),
+		MaxPlayers:     int32(lobby.MaxPlayers),
+		Reason:         reason,
+	})
+
+	w.logger.Info("Lobby closed",
+		zap.String("lobby_id", lobby.ID),
+		zap.String("reason", reason))
+
+	return nil
+}
@@ -3,10 +3,20 @@ package lobby
 import "time"
 
 type Config struct {
-	TickerTimeout    time.Duration `mapstructure:"tickerTimeout" default:"1s"`
-	MaxLobbyWait     time.Duration `mapstructure:"maxLobbyWait" default:"1m"`
-	LobbyIdleExtend  time.Duration `mapstructure:"lobbyIdleExtend" default:"15s"`
-	MinReadyDuration time.Duration `mapstructure:"minReadyDuration" default:"10s"`
+	TickerTimeout         time.Duration `mapstructure:"tickerTimeout" default:"1s"`
+	MaxLobbyWait          time.Duration `mapstructure:"maxLobbyWait" default:"1m"`
+	LobbyIdleExtend       time.Duration `mapstructure:"lobbyIdleExtend" default:"15s"`
+	MinReadyDuration      time.Duration `mapstructure:"minReadyDuration" default:"10s"`
+	MaxAllocationAttempts int           `mapstructure:"maxAllocationAttempts" default:"3"`
+	RequeueExpired        bool          `mapstructure:"requeueExpired" default:"false"`
+	RequeueLobbiesLimit   int           `mapstructure:"requeueLobbiesLimit" default:"25"`
+	MergeInterval         time.Duration `mapstructure:"mergeInterval" default:"10s"`
+	MergeModes            []string      `mapstructure:"mergeModes"`
+	RequeueDeclined       bool          `mapstructure:"requeueDeclined" default:"false"`
+	LeaseTTL              time.Duration `mapstructure:"leaseTTL" default:"10s"`
+	SupervisorInterval    time.Duration `mapstructure:"supervisorInterval" default:"5s"`
+	StartClaimTTL         time.Duration `mapstructure:"startClaimTTL" default:"30s"`
+	MaxReadFailures       int           `mapstructure:"maxReadFailures" default:"5"`
 }
 
 func (w *Waiter) SectionKey() string {
@@ -58,3 +68,84 @@ func (w *Waiter) getMinReadyDuration() time.Duration {
 	}
 	return w.cfg.MinReadyDuration
 }
+
+func (w *Waiter) getMaxAllocationAttempts() int {
+	w.mx.RLock()
+	defer w.mx.RUnlock()
+	if w.cfg.MaxAllocationAttempts < 1 {
+		return 1
+	}
+	return w.cfg.MaxAllocationAttempts
+}
+
+func (w *Waiter) getRequeueExpired() bool {
+	w.mx.RLock()
+	defer w.mx.RUnlock()
+	return w.cfg.RequeueExpired
+}
+
+func (w *Waiter) getRequeueLobbiesLimit() int {
+	w.mx.RLock()
+	defer w.mx.RUnlock()
+	if w.cfg.RequeueLobbiesLimit < 10 {
+		return 10
+	}
+	return w.cfg.RequeueLobbiesLimit
+}
+
+func (w *Waiter) getRequeueDeclined() bool {
+	w.mx.RLock()
+	defer w.mx.RUnlock()
+	return w.cfg.RequeueDeclined
+}
+
+func (w *Waiter) getMergeInterval() time.Duration {
+	w.mx.RLock()
+	defer w.mx.RUnlock()
+	if w.cfg.MergeInterval < time.Second {
+		return time.Second
+	}
+	return w.cfg.MergeInterval
+}
+
+func (w *Waiter) getMergeModes() []string {
+	w.mx.RLock()
+	defer w.mx.RUnlock()
+	return append([]string(nil), w.cfg.MergeModes...)
+}
+
+func (w *Waiter) getLeaseTTL() time.Duration {
+	w.mx.RLock()
+	defer w.mx.RUnlock()
+	if w.cfg.LeaseTTL < time.Second*3 {
+		return time.Second * 3
+	}
+	return w.cfg.LeaseTTL
+}
+
+func (w *Waiter) getSupervisorInterval() time.Duration {
+	w.mx.RLock()
+	defer w.mx.RUnlock()
+	if w.cfg.SupervisorInterval < time.Second {
+		return time.Second
+	}
+	return w.cfg.SupervisorInterval
+}
+
+func (w *Waiter) getStartClaimTTL() time.Duration {
+	w.mx.RLock()
+	defer w.mx.RUnlock()
+	if w.cfg.StartClaimTTL < time.Second*5 {
+		return time.Second * 5
+	}
+	return w.cfg.StartClaimTTL
+}
+
+func (w *Waiter) getMaxReadFailures() int {
+	w.mx.RLock()
+	defer w.mx.RUnlock()
+	if w.cfg.MaxReadFailures < 1 {
+		return 1
+	}
+	return w.cfg.MaxReadFailures
+}
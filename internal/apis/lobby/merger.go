@@ -0,0 +1,134 @@
+package lobby
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	lobbyv1 "github.com/QuizWars-Ecosystem/lobby-service/gen/external/lobby/v1"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/metrics"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"go.uber.org/zap"
+)
+
+const mergeTimeout = time.Second * 5
+
+func (w *Waiter) RunMerger(ctx context.Context) {
+	timer := time.NewTimer(w.getMergeInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			for _, mode := range w.getMergeModes() {
+				w.mergeLobbies(ctx, mode)
+			}
+
+			timer.Reset(w.getMergeInterval())
+		}
+	}
+}
+
+func (w *Waiter) mergeLobbies(ctx context.Context, mode string) {
+	mergeCtx, cancel := context.WithTimeout(ctx, mergeTimeout)
+	defer cancel()
+
+	lobbies, err := w.store.GetLobbies(mergeCtx, mode)
+	if err != nil {
+		w.logger.Warn("Failed to load lobbies for merge", zap.String("mode", mode), zap.Error(err))
+		return
+	}
+
+	underFilled := make([]*models.Lobby, 0, len(lobbies))
+	for _, l := range lobbies {
+		if len(l.Players) > 0 && len(l.Players) < int(l.MinPlayers) {
+			underFilled = append(underFilled, l)
+		}
+	}
+
+	sort.SliceStable(underFilled, func(i, j int) bool {
+		if len(underFilled[i].Players) != len(underFilled[j].Players) {
+			return len(underFilled[i].Players) > len(underFilled[j].Players)
+		}
+		return underFilled[i].CreatedAt.Before(underFilled[j].CreatedAt)
+	})
+
+	merged := make(map[string]bool, len(underFilled))
+
+	for i, target := range underFilled {
+		if merged[target.ID] {
+			continue
+		}
+
+		for _, source := range underFilled[i+1:] {
+			if merged[source.ID] || !w.canMerge(source, target) {
+				continue
+			}
+
+			from, to, err := w.store.MergeLobbies(mergeCtx, source.ID, target.ID, w.canMerge)
+			if err != nil {
+				if !errors.Is(err, store.ErrLobbiesMismatch) && !errors.Is(err, store.ErrLobbyFull) && !errors.Is(err, store.ErrLobbyNotFound) {
+					w.logger.Warn("Failed to merge lobbies",
+						zap.String("source_id", source.ID),
+						zap.String("target_id", target.ID),
+						zap.Error(err))
+				}
+				continue
+			}
+
+			merged[from.ID] = true
+			target = to
+
+			w.redirectMergedPlayers(mergeCtx, from, to)
+
+			if len(target.Players) >= int(target.MinPlayers) {
+				break
+			}
+		}
+	}
+}
+
+func (w *Waiter) canMerge(source, target *models.Lobby) bool {
+	if len(source.Players) >= int(source.MinPlayers) || len(target.Players) >= int(target.MinPlayers) {
+		return false
+	}
+
+	return len(w.finder.Matcher().FilterLobbies(target.Mode, []*models.Lobby{target}, source.Players)) > 0
+}
+
+func (w *Waiter) redirectMergedPlayers(ctx context.Context, source, target *models.Lobby) {
+	defer metrics.LobbyStatusChanges.WithLabelValues("merged").Inc()
+
+	parties := make(map[string]struct{})
+
+	for _, p := range source.Players {
+		w.streamer.RedirectStream(source.ID, p.ID, &lobbyv1.LobbyStatus{
+			LobbyId:        target.ID,
+			Status:         lobbyv1.Status_STATUS_WAITING,
+			CurrentPlayers: int32(len(target.Players)),
+			MaxPlayers:     int32(target.MaxPlayers),
+		})
+
+		if p.PartyID != "" {
+			parties[p.PartyID] = struct{}{}
+		}
+	}
+
+	for partyID := range parties {
+		if err := w.store.SetPartyLobby(ctx, partyID, target.ID, time.Until(target.ExpireAt)); err != nil {
+			w.logger.Warn("Failed to move party to merged lobby",
+				zap.String("party_id", partyID),
+				zap.String("lobby_id", target.ID),
+				zap.Error(err))
+		}
+	}
+
+	w.logger.Debug("Lobbies merged",
+		zap.String("source_id", source.ID),
+		zap.String("target_id", target.ID),
+		zap.Int("players", len(target.Players)))
+}
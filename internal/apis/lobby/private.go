@@ -0,0 +1,42 @@
+package lobby
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+)
+
+var (
+	ErrNotLobbyHost     = errors.New("player is not a lobby host")
+	ErrNotEnoughPlayers = errors.New("not enough players to start a lobby")
+)
+
+func (w *Waiter) StartPrivateLobby(ctx context.Context, lobbyID, playerID string) error {
+	lobby, err := w.store.GetLobby(ctx, lobbyID)
+	if err != nil {
+		return err
+	}
+
+	if !lobby.Private || lobby.HostID != playerID {
+		return ErrNotLobbyHost
+	}
+
+	if int16(len(lobby.Players)) < lobby.MinPlayers {
+		return ErrNotEnoughPlayers
+	}
+
+	if lobby.InReadyCheck() {
+		return nil
+	}
+
+	if err = w.handleReadyLobby(ctx, lobby); err != nil {
+		return err
+	}
+
+	w.logger.Debug("Private lobby started by host",
+		zap.String("lobby_id", lobby.ID),
+		zap.String("host_id", playerID))
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package lobby
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	lobbyv1 "github.com/QuizWars-Ecosystem/lobby-service/gen/external/lobby/v1"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/metrics"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"go.uber.org/zap"
+)
+
+var ErrNotInReadyCheck = errors.New("lobby is not in ready check")
+
+const (
+	reasonMatchDeclined     = "match declined"
+	reasonMatchNotAccepted  = "match was not accepted in time"
+	reasonNotEnoughAccepted = "not enough players accepted a match"
+)
+
+func (w *Waiter) AcceptMatch(ctx context.Context, lobbyID, playerID string) error {
+	_, err := w.store.UpdateLobby(ctx, lobbyID, func(lobby *models.Lobby) error {
+		if !lobby.InReadyCheck() {
+			return ErrNotInReadyCheck
+		}
+
+		if !lobby.AcceptMatch(playerID) {
+			return store.ErrPlayerNotInLobby
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+func (w *Waiter) DeclineMatch(ctx context.Context, lobbyID, playerID string) error {
+	lobby, err := w.store.UpdateLobby(ctx, lobbyID, func(lobby *models.Lobby) error {
+		if !lobby.InReadyCheck() {
+			return ErrNotInReadyCheck
+		}
+
+		if !lobby.RemovePlayer(playerID) {
+			return store.ErrPlayerNotInLobby
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	metrics.LobbyPlayersCount.WithLabelValues(lobby.ID, lobby.Mode).Set(float64(len(lobby.Players)))
+
+	w.removeFromReadyCheck(lobby, playerID, reasonMatchDeclined)
+
+	return nil
+}
+
+// startReadyCheck is safe to race, only the caller which actually started the check announces it.
+func (w *Waiter) startReadyCheck(ctx context.Context, lobby *models.Lobby) error {
+	var started bool
+
+	updated, err := w.store.UpdateLobby(ctx, lobby.ID, func(l *models.Lobby) error {
+		if !l.InReadyCheck() {
+			l.StartReadyCheck()
+			started = true
+		}
+
+		return nil
+	})
+	if err != nil || !started {
+		return err
+	}
+
+	metrics.LobbyStatusChanges.WithLabelValues("ready_check").Inc()
+
+	w.broadcastReadyCheck(updated)
+	return nil
+}
+
+func (w *Waiter) handleReadyCheck(ctx context.Context, lobby *models.Lobby) error {
+	timedOut := time.Since(lobby.ReadyCheckStartedAt) >= lobby.ReadyCheck
+
+	switch {
+	case int16(len(lobby.Players)) >= lobby.MinPlayers && lobby.AllAccepted():
+		return w.startLobby(ctx, lobby)
+	case timedOut || int16(len(lobby.Players)) < lobby.MinPlayers:
+		return w.resolveReadyCheck(ctx, lobby)
+	default:
+		w.broadcastReadyCheck(lobby)
+		return nil
+	}
+}
+
+// resolveReadyCheck ends a ready check which timed out or lost too many players to declines.
+// Only a timed out check removes the players who did not answer, before that they are still
+// inside their accept window and stay queued.
+func (w *Waiter) resolveReadyCheck(ctx context.Context, lobby *models.Lobby) error {
+	var (
+		removed  []*models.Player
+		timedOut bool
+	)
+
+	updated, err := w.store.UpdateLobby(ctx, lobby.ID, func(l *models.Lobby) error {
+		if !l.InReadyCheck() {
+			return ErrNotInReadyCheck
+		}
+
+		timedOut = time.Since(l.ReadyCheckStartedAt) >= l.ReadyCheck
+
+		if timedOut {
+			removed = l.NotAccepted()
+			for _, p := range removed {
+				l.RemovePlayer(p.ID)
+			}
+		}
+
+		if int16(len(l.Players)) < l.MinPlayers {
+			l.StopReadyCheck()
+		}
+
+		return nil
+	})
+	if errors.Is(err, ErrNotInReadyCheck) {
+		// Resolved by another supervisor meanwhile.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, p := range removed {
+		w.removeFromReadyCheck(updated, p.ID, reasonMatchNotAccepted)
+	}
+
+	if updated.InReadyCheck() {
+		if !timedOut {
+			w.broadcastReadyCheck(updated)
+			return nil
+		}
+
+		return w.startLobby(ctx, updated)
+	}
+
+	if w.getRequeueDeclined() {
+		w.removeLobby(ctx, updated, "ready_check_failed")
+		w.requeuePlayers(ctx, updated)
+
+		w.broadcastStatus(updated.ID, &lobbyv1.LobbyStatus{
+			LobbyId:        updated.ID,
+			Status:         lobbyv1.Status_STATUS_TIMEOUT,
+			CurrentPlayers: int32(len(updated.Players)),
+			Reason:         reasonNotEnoughAccepted,
+		})
+
+		return nil
+	}
+
+	w.logger.Debug("Lobby returned to waiting after ready check",
+		zap.String("lobby_id", updated.ID),
+		zap.Int("removed_players", len(removed)))
+
+	w.broadcastLobbyStatus(ctx, updated, lobbyv1.Status_STATUS_WAITING)
+	return nil
+}
+
+func (w *Waiter) removeFromReadyCheck(lobby *models.Lobby, playerID, reason string) {
+	w.broadcastStatus(lobby.ID, &lobbyv1.LobbyStatus{
+		LobbyId:        lobby.ID,
+		Status:         lobbyv1.Status_STATUS_TIMEOUT,
+		CurrentPlayers: int32(len(lobby.Players)),
+		MaxPlayers:     int32(lobby.MaxPlayers),
+		Reason:         reason,
+		PlayerId:       playerID,
+	})
+}
+
+func (w *Waiter) broadcastReadyCheck(lobby *models.Lobby) {
+	remaining := lobby.ReadyCheck - time.Since(lobby.ReadyCheckStartedAt)
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	w.broadcastStatus(lobby.ID, &lobbyv1.LobbyStatus{
+		LobbyId:        lobby.ID,
+		Status:         lobbyv1.Status_STATUS_READY_CHECK,
+		CurrentPlayers: int32(len(lobby.Players)),
+		MaxPlayers:     int32(lobby.MaxPlayers),
+		AcceptTimeout:  int32(remaining.Seconds()),
+	})
+}
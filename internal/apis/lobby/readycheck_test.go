@@ -0,0 +1,77 @@
+package lobby_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/allocator"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/lobby"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/matchmaking"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/stats"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/streamer"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/matcher"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newTestWaiter returns a waiter over an in-memory store, statuses are not published without
+// a NATS connection.
+func newTestWaiter(t *testing.T, s store.LobbyStore, gameAllocator allocator.GameAllocator) *lobby.Waiter {
+	m, err := matchmaking.NewMatcher(&matcher.Config{
+		Configs: map[string]matcher.ScoringConfig{matcher.DefaultConfigKey: {
+			RatingWeight:     0.4,
+			CategoryWeight:   0.4,
+			FillWeight:       0.2,
+			MaxRatingDiff:    500,
+			MinCategoryMatch: 0.3,
+		}},
+	})
+	require.NoError(t, err)
+
+	w := lobby.NewWaiter(
+		s,
+		streamer.NewStreamManager(nil, s, zap.NewNop()),
+		gameAllocator,
+		matchmaking.NewFinder(m, s, zap.NewNop()),
+		stats.NewCollector(s, zap.NewNop(), &stats.Config{}),
+		zap.NewNop(),
+		&lobby.Config{},
+	)
+	t.Cleanup(w.Stop)
+
+	return w
+}
+
+func TestDeclineBeforeReadyCheckTimeout(t *testing.T) {
+	ctx := t.Context()
+	s := store.NewMemoryStore(&scorer.Ranking{})
+	w := newTestWaiter(t, s, allocator.NewMemoryAllocator())
+
+	players := []*models.Player{{ID: "accepted"}, {ID: "undecided"}, {ID: "declined"}}
+	l := models.NewLobby("lobby-1", "classic", players, time.Minute)
+	l.MinPlayers = 3
+	l.MaxPlayers = 4
+	l.ReadyCheck = time.Minute
+	l.StartReadyCheck()
+	require.NoError(t, s.AddLobby(ctx, l))
+
+	require.NoError(t, w.AcceptMatch(ctx, l.ID, "accepted"))
+	require.NoError(t, w.DeclineMatch(ctx, l.ID, "declined"))
+
+	stop := w.Supervise(l)
+	defer stop()
+
+	require.Eventually(t, func() bool {
+		stored, err := s.GetLobby(ctx, l.ID)
+		return err == nil && !stored.InReadyCheck()
+	}, time.Second*5, time.Millisecond*100)
+
+	stored, err := s.GetLobby(ctx, l.ID)
+	require.NoError(t, err)
+	require.True(t, stored.HasPlayer("accepted"))
+	require.True(t, stored.HasPlayer("undecided"))
+	require.False(t, stored.HasPlayer("declined"))
+}
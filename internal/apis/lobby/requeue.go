@@ -0,0 +1,63 @@
+package lobby
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	lobbyv1 "github.com/QuizWars-Ecosystem/lobby-service/gen/external/lobby/v1"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/matchmaking"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/metrics"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"go.uber.org/zap"
+)
+
+const (
+	requeueTimeout  = time.Second * 5
+	requeueAttempts = 3
+)
+
+func (w *Waiter) requeuePlayers(ctx context.Context, lobby *models.Lobby) {
+	requeueCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), requeueTimeout)
+	defer cancel()
+
+	units := models.GroupByParty(lobby.Players)
+
+	sort.SliceStable(units, func(i, j int) bool {
+		return units[i][0].JoinedAt.Before(units[j][0].JoinedAt)
+	})
+
+	for _, unit := range units {
+		target, err := w.finder.FindLobby(requeueCtx, []string{lobby.Mode}, unit, matchmaking.SearchOptions{
+			Limit:       w.getRequeueLobbiesLimit(),
+			Attempts:    requeueAttempts,
+			ExcludedIDs: []string{lobby.ID},
+		})
+		if err != nil {
+			w.logger.Warn("Failed to requeue players",
+				zap.String("lobby_id", lobby.ID),
+				zap.Error(err))
+			return
+		}
+
+		if target == nil {
+			continue
+		}
+
+		metrics.LobbyStatusChanges.WithLabelValues("requeued").Inc()
+
+		for _, p := range unit {
+			w.streamer.RedirectStream(lobby.ID, p.ID, &lobbyv1.LobbyStatus{
+				LobbyId:        target.ID,
+				Status:         lobbyv1.Status_STATUS_WAITING,
+				CurrentPlayers: int32(len(target.Players) + len(unit)),
+				MaxPlayers:     int32(target.MaxPlayers),
+			})
+		}
+
+		w.logger.Debug("Players requeued",
+			zap.String("from_lobby_id", lobby.ID),
+			zap.String("to_lobby_id", target.ID),
+			zap.Int("players", len(unit)))
+	}
+}
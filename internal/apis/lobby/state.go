@@ -2,23 +2,33 @@ package lobby
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	lobbyv1 "github.com/QuizWars-Ecosystem/lobby-service/gen/external/lobby/v1"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/allocator"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/metrics"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
+var (
+	ErrLobbyStarting    = store.ErrLobbyStarting
+	errAllocationFailed = errors.New("game allocation failed")
+)
+
 type State string
 
 const (
-	StateWaiting  State = "waiting"
-	StateReady    State = "ready"
-	StateExpired  State = "expired"
-	StateInactive State = "inactive"
-	StateError    State = "error"
+	StateWaiting    State = "waiting"
+	StateReady      State = "ready"
+	StateReadyCheck State = "ready_check"
+	StateExpired    State = "expired"
+	StateInactive   State = "inactive"
+	StateError      State = "error"
 )
 
 func (w *Waiter) determineState(lobby *models.Lobby) State {
@@ -28,6 +38,9 @@ func (w *Waiter) determineState(lobby *models.Lobby) State {
 	case time.Now().After(lobby.ExpireAt):
 		return StateExpired
 
+	case lobby.InReadyCheck():
+		return StateReadyCheck
+
 	case playerCount == 0 && time.Since(lobby.CreatedAt) > w.getMaxLobbyWait():
 		return StateInactive
 
@@ -43,6 +56,8 @@ func (w *Waiter) handleState(ctx context.Context, state State, lobby *models.Lob
 	switch state {
 	case StateReady:
 		return w.handleReadyLobby(ctx, lobby)
+	case StateReadyCheck:
+		return w.handleReadyCheck(ctx, lobby)
 	case StateExpired:
 		return w.handleExpiredLobby(ctx, lobby)
 	case StateInactive:
@@ -50,11 +65,57 @@ func (w *Waiter) handleState(ctx context.Context, state State, lobby *models.Lob
 	case StateError:
 		return w.handleErrorState(lobby)
 	default:
-		return w.handleWaitingState(lobby)
+		return w.handleWaitingState(ctx, lobby)
 	}
 }
 
 func (w *Waiter) handleReadyLobby(ctx context.Context, lobby *models.Lobby) error {
+	if lobby.ReadyCheck > 0 {
+		return w.startReadyCheck(ctx, lobby)
+	}
+
+	return w.startLobby(ctx, lobby)
+}
+
+// startLobby allocates a game for the lobby and removes it from the queue. Supervisors, admins
+// and hosts may start the same lobby at once, so only the caller claiming the start proceeds
+// and it works on the lobby as stored after the claim.
+func (w *Waiter) startLobby(ctx context.Context, lobby *models.Lobby) error {
+	lobbyID, claim := lobby.ID, uuid.NewString()
+
+	claimed, err := w.store.ClaimLobbyStart(ctx, lobbyID, claim, w.getStartClaimTTL())
+	if err != nil {
+		return err
+	}
+
+	if !claimed {
+		return ErrLobbyStarting
+	}
+
+	started := false
+	defer func() {
+		if !started {
+			w.releaseStartClaim(lobbyID, claim)
+		}
+	}()
+
+	if lobby, err = w.store.GetLobby(ctx, lobbyID); err != nil {
+		return err
+	}
+
+	if len(lobby.Players) == 0 {
+		return ErrEmptyLobby
+	}
+
+	teams := models.BalanceTeams(lobby.Players, int(lobby.Teams))
+
+	gameID, err := w.allocator.Allocate(ctx, allocator.NewAllocateGameRequest(lobby, teams))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errAllocationFailed, err)
+	}
+
+	started = true
+
 	defer metrics.LobbyStatusChanges.WithLabelValues("starting").Inc()
 
 	status := &lobbyv1.LobbyStatus{
@@ -62,15 +123,39 @@ func (w *Waiter) handleReadyLobby(ctx context.Context, lobby *models.Lobby) erro
 		Status:         lobbyv1.Status_STATUS_STARTING,
 		CurrentPlayers: int32(len(lobby.Players)),
 		MaxPlayers:     int32(lobby.MaxPlayers),
+		GameId:         gameID,
+		Teams:          teamAssignments(lobby.Players, teams),
 	}
 
 	w.removeLobby(ctx, lobby, "starting")
 
 	w.broadcastStatus(lobby.ID, status)
 	metrics.LobbyWaitTime.WithLabelValues(lobby.Mode).Observe(time.Since(lobby.CreatedAt).Seconds())
+	recordMatchQuality(lobby)
+	w.stats.RecordWaitTime(ctx, lobby.Mode, time.Since(lobby.CreatedAt))
 	return nil
 }
 
+func (w *Waiter) handleAllocationFailure(ctx context.Context, lobby *models.Lobby, err error) {
+	defer metrics.LobbyStatusChanges.WithLabelValues("error").Inc()
+
+	status := &lobbyv1.LobbyStatus{
+		LobbyId:        lobby.ID,
+		Status:         lobbyv1.Status_STATUS_ERROR,
+		CurrentPlayers: int32(len(lobby.Players)),
+		MaxPlayers:     int32(lobby.MaxPlayers),
+		Reason:         "failed to allocate game session",
+	}
+
+	w.removeLobby(ctx, lobby, "allocation_failed")
+
+	w.broadcastStatus(lobby.ID, status)
+
+	w.logger.Error("Lobby removed after game allocation failures",
+		zap.String("lobby_id", lobby.ID),
+		zap.Error(err))
+}
+
 func (w *Waiter) handleExpiredLobby(ctx context.Context, lobby *models.Lobby) error {
 	defer metrics.LobbyStatusChanges.WithLabelValues("timeout").Inc()
 
@@ -82,18 +167,22 @@ func (w *Waiter) handleExpiredLobby(ctx context.Context, lobby *models.Lobby) er
 
 	w.removeLobby(ctx, lobby, "timeout")
 
+	if w.getRequeueExpired() && !lobby.Private {
+		w.requeuePlayers(ctx, lobby)
+	}
+
 	w.broadcastStatus(lobby.ID, status)
 	return nil
 }
 
-func (w *Waiter) handleWaitingState(lobby *models.Lobby) error {
+func (w *Waiter) handleWaitingState(ctx context.Context, lobby *models.Lobby) error {
 	playerCount := int16(len(lobby.Players))
 
 	if w.shouldExtendLobby(lobby, playerCount) {
 		lobby.ExpireAt = lobby.ExpireAt.Add(w.getLobbyIdleExtend())
 	}
 
-	w.broadcastLobbyStatus(lobby.ID, playerCount, lobby.MaxPlayers, lobbyv1.Status_STATUS_WAITING)
+	w.broadcastLobbyStatus(ctx, lobby, lobbyv1.Status_STATUS_WAITING)
 	return nil
 }
 
@@ -117,7 +206,7 @@ func (w *Waiter) handleErrorState(lobby *models.Lobby) error {
 		Status:  lobbyv1.Status_STATUS_ERROR,
 	}
 
-	w.streamer.BroadcastLobbyUpdate(lobby.ID, status)
+	w.broadcastStatus(lobby.ID, status)
 
 	w.logger.Error("Lobby entered error state",
 		zap.String("lobby_id", lobby.ID),
@@ -126,6 +215,15 @@ func (w *Waiter) handleErrorState(lobby *models.Lobby) error {
 	return fmt.Errorf("lobby %s in error state", lobby.ID)
 }
 
+func (w *Waiter) releaseStartClaim(lobbyID, claim string) {
+	ctx, cancel := context.WithTimeout(context.Background(), leaseReleaseTimeout)
+	defer cancel()
+
+	if err := w.store.ReleaseLobbyStart(ctx, lobbyID, claim); err != nil {
+		w.logger.Warn("Failed to release lobby start claim", zap.String("lobby_id", lobbyID), zap.Error(err))
+	}
+}
+
 func (w *Waiter) removeLobby(ctx context.Context, lobby *models.Lobby, reason string) {
 	if err := w.store.RemoveLobby(ctx, lobby.ID, lobby.Mode); err != nil {
 		w.logger.Warn("Failed to remove lobby",
@@ -141,12 +239,41 @@ func (w *Waiter) removeLobby(ctx context.Context, lobby *models.Lobby, reason st
 		zap.String("lobby_id", lobby.ID))
 }
 
+func (w *Waiter) estimateWait(ctx context.Context, lobby *models.Lobby) time.Duration {
+	if int16(len(lobby.Players)) >= lobby.MinPlayers {
+		return max(w.getMinReadyDuration()-time.Since(lobby.LastJoinedAt), 0)
+	}
+
+	queueStats, err := w.stats.Stats(ctx, lobby.Mode)
+	if err != nil {
+		w.logger.Debug("Failed to get queue stats", zap.String("mode", lobby.Mode), zap.Error(err))
+		return 0
+	}
+
+	return queueStats.EstimateWait(time.Since(lobby.CreatedAt))
+}
+
+func (w *Waiter) keepWaiting(state State, lobby *models.Lobby) bool {
+	switch state {
+	case StateWaiting, StateReadyCheck:
+		return true
+	case StateReady:
+		return lobby.ReadyCheck > 0
+	default:
+		return false
+	}
+}
+
 func (w *Waiter) isLobbyReady(lobby *models.Lobby, playerCount int16) bool {
 	if playerCount < lobby.MinPlayers {
 		return false
 	}
 
 	isFull := playerCount >= lobby.MaxPlayers
+	if lobby.Private {
+		return isFull
+	}
+
 	minWaitPassed := time.Since(lobby.LastJoinedAt) >= w.getMinReadyDuration()
 
 	return isFull || minWaitPassed
@@ -170,31 +297,41 @@ func (w *Waiter) shouldExtendLobby(lobby *models.Lobby, playerCount int16) bool
 	return shouldExtend
 }
 
-func (w *Waiter) broadcastLobbyStatus(lobbyID string, current, max int16, status lobbyv1.Status) {
+func (w *Waiter) broadcastLobbyStatus(ctx context.Context, lobby *models.Lobby, status lobbyv1.Status) {
 	s := &lobbyv1.LobbyStatus{
-		LobbyId:        lobbyID,
-		CurrentPlayers: int32(current),
-		MaxPlayers:     int32(max),
+		LobbyId:        lobby.ID,
+		CurrentPlayers: int32(len(lobby.Players)),
+		MaxPlayers:     int32(lobby.MaxPlayers),
 		Status:         status,
+		RatingWindow:   int32(w.finder.Matcher().RatingWindow(lobby)),
+		EstimatedWait:  int32(w.estimateWait(ctx, lobby).Seconds()),
 	}
 
-	w.streamer.BroadcastLobbyUpdate(lobbyID, s)
+	w.broadcastStatus(lobby.ID, s)
+}
 
-	if err := w.streamer.PublishLobbyStatus(lobbyID, s); err != nil {
+func (w *Waiter) broadcastStatus(lobbyID string, status *lobbyv1.LobbyStatus) {
+	if err := w.streamer.PublishLobbyStatus(lobbyID, status); err != nil {
 		w.logger.Warn("Failed to publish lobby status",
 			zap.String("id", lobbyID),
 			zap.Error(err))
 	}
 }
 
-func (w *Waiter) broadcastStatus(lobbyID string, status *lobbyv1.LobbyStatus) {
-	w.streamer.BroadcastLobbyUpdate(lobbyID, status)
+func teamAssignments(players []*models.Player, teams map[string]int32) []*lobbyv1.TeamAssignment {
+	if len(teams) == 0 {
+		return nil
+	}
 
-	if err := w.streamer.PublishLobbyStatus(lobbyID, status); err != nil {
-		w.logger.Warn("Failed to publish lobby status",
-			zap.String("id", lobbyID),
-			zap.Error(err))
+	assignments := make([]*lobbyv1.TeamAssignment, 0, len(players))
+	for _, p := range players {
+		assignments = append(assignments, &lobbyv1.TeamAssignment{
+			PlayerId: p.ID,
+			Team:     teams[p.ID],
+		})
 	}
+
+	return assignments
 }
 
 func (w *Waiter) initMetrics(lobby *models.Lobby) {
@@ -206,3 +343,17 @@ func (w *Waiter) cleanupMetrics(lobby *models.Lobby) {
 	metrics.LobbyPlayersCount.DeleteLabelValues(lobby.ID, lobby.Mode)
 	metrics.ModeLobbiesCount.WithLabelValues(lobby.Mode).Dec()
 }
+
+// recordMatchQuality observes how fair a started lobby is, so scoring weights can be tuned against real matches.
+func recordMatchQuality(lobby *models.Lobby) {
+	q := lobby.MatchQuality()
+
+	metrics.LobbyRatingSpread.WithLabelValues(lobby.Mode).Observe(q.RatingSpread)
+	metrics.LobbyRatingStdDev.WithLabelValues(lobby.Mode).Observe(q.RatingStdDev)
+	metrics.LobbyCategoryOverlap.WithLabelValues(lobby.Mode).Observe(q.CategoryOverlap)
+	metrics.LobbyFillRatio.WithLabelValues(lobby.Mode).Observe(q.FillRatio)
+
+	for _, wait := range lobby.PlayerWaits(time.Now()) {
+		metrics.PlayerWaitTime.WithLabelValues(lobby.Mode).Observe(wait.Seconds())
+	}
+}
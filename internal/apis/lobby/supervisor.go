@@ -0,0 +1,180 @@
+package lobby
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/metrics"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"go.uber.org/zap"
+)
+
+const (
+	supervisorTimeout    = time.Second * 5
+	supervisorBatchLimit = 50
+	leaseReleaseTimeout  = time.Second * 2
+	leaseAcquireAttempts = 3
+	leaseAcquireDelay    = time.Millisecond * 100
+)
+
+// Supervise takes the lobby lease and runs its state machine on this instance,
+// detached from the request that created the lobby. The returned func stops it.
+// A lobby whose lease can not be taken for store errors is left to adoption by any instance.
+func (w *Waiter) Supervise(lobby *models.Lobby) context.CancelFunc {
+	acquired, err := w.acquireLease(lobby.ID)
+
+	switch {
+	case acquired:
+		if err != nil {
+			// the lease is held, renewals track it for adoption again
+			w.logger.Warn("Failed to track lobby lease", zap.String("lobby_id", lobby.ID), zap.Error(err))
+		}
+
+		return w.supervise(lobby)
+	case err != nil:
+		w.logger.Warn("Failed to acquire lobby lease, leaving lobby to adoption",
+			zap.String("lobby_id", lobby.ID),
+			zap.Error(err))
+
+		w.orphanLease(lobby.ID)
+	default:
+		w.logger.Warn("Lobby lease is taken", zap.String("lobby_id", lobby.ID))
+	}
+
+	return func() {}
+}
+
+func (w *Waiter) acquireLease(lobbyID string) (bool, error) {
+	for attempt := 1; ; attempt++ {
+		acquired, err := w.store.AcquireLease(w.ctx, lobbyID, w.owner, w.getLeaseTTL())
+		if err == nil || acquired || attempt == leaseAcquireAttempts {
+			return acquired, err
+		}
+
+		select {
+		case <-w.ctx.Done():
+			return false, w.ctx.Err()
+		case <-time.After(leaseAcquireDelay):
+		}
+	}
+}
+
+func (w *Waiter) supervise(lobby *models.Lobby) context.CancelFunc {
+	ctx, cancel := context.WithTimeout(w.ctx, time.Until(lobby.ExpireAt)+w.getLeaseTTL())
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		defer cancel()
+
+		w.WaitForLobbyFill(ctx, lobby)
+	}()
+
+	return cancel
+}
+
+// RunSupervisor adopts lobbies whose owner stopped renewing the lease,
+// e.g. after the owning instance crashed.
+func (w *Waiter) RunSupervisor(ctx context.Context) {
+	timer := time.NewTimer(w.getSupervisorInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			w.adoptOrphans(ctx)
+
+			timer.Reset(w.getSupervisorInterval())
+		}
+	}
+}
+
+// Stop ends every local supervision and hands its leases over to other instances.
+func (w *Waiter) Stop() {
+	w.cancel()
+	w.wg.Wait()
+}
+
+func (w *Waiter) adoptOrphans(ctx context.Context) {
+	if w.ctx.Err() != nil {
+		return
+	}
+
+	scanCtx, cancel := context.WithTimeout(ctx, supervisorTimeout)
+	defer cancel()
+
+	ids, err := w.store.GetExpiredLeases(scanCtx, supervisorBatchLimit)
+	if err != nil {
+		w.logger.Warn("Failed to load expired lobby leases", zap.Error(err))
+		return
+	}
+
+	for _, id := range ids {
+		acquired, err := w.store.AcquireLease(scanCtx, id, w.owner, w.getLeaseTTL())
+		if err != nil || !acquired {
+			continue
+		}
+
+		lobby, err := w.store.GetLobby(scanCtx, id)
+		if err != nil {
+			if !errors.Is(err, store.ErrLobbyNotFound) {
+				w.logger.Warn("Failed to load orphaned lobby", zap.String("lobby_id", id), zap.Error(err))
+			}
+
+			w.releaseLease(id)
+			continue
+		}
+
+		w.supervise(lobby)
+
+		metrics.LobbyStatusChanges.WithLabelValues("adopted").Inc()
+
+		w.logger.Info("Orphaned lobby adopted", zap.String("lobby_id", id), zap.String("mode", lobby.Mode))
+	}
+}
+
+func (w *Waiter) renewLease(ctx context.Context, lobbyID string) bool {
+	renewed, err := w.store.RenewLease(ctx, lobbyID, w.owner, w.getLeaseTTL())
+	if err != nil {
+		// Transient store errors must not drop supervision, the lease lasts several ticks.
+		w.logger.Warn("Failed to renew lobby lease", zap.String("lobby_id", lobbyID), zap.Error(err))
+		return true
+	}
+
+	if !renewed {
+		w.logger.Warn("Lobby lease lost, stop supervising", zap.String("lobby_id", lobbyID))
+	}
+
+	return renewed
+}
+
+func (w *Waiter) releaseLease(lobbyID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), leaseReleaseTimeout)
+	defer cancel()
+
+	if err := w.store.ReleaseLease(ctx, lobbyID, w.owner); err != nil {
+		w.logger.Warn("Failed to release lobby lease", zap.String("lobby_id", lobbyID), zap.Error(err))
+	}
+}
+
+func (w *Waiter) orphanLease(lobbyID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), leaseReleaseTimeout)
+	defer cancel()
+
+	if err := w.store.OrphanLease(ctx, lobbyID); err != nil {
+		w.logger.Error("Failed to leave lobby to adoption", zap.String("lobby_id", lobbyID), zap.Error(err))
+	}
+}
+
+func (w *Waiter) handoffLease(lobbyID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), leaseReleaseTimeout)
+	defer cancel()
+
+	if err := w.store.HandoffLease(ctx, lobbyID, w.owner); err != nil {
+		w.logger.Warn("Failed to hand off lobby lease", zap.String("lobby_id", lobbyID), zap.Error(err))
+	}
+}
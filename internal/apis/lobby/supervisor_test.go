@@ -0,0 +1,45 @@
+package lobby_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/allocator"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
+	"github.com/stretchr/testify/require"
+)
+
+var errStoreDown = errors.New("store is down")
+
+// leaseFailingStore fails every lease acquisition, the rest of the store works.
+type leaseFailingStore struct {
+	store.LobbyStore
+}
+
+func (s *leaseFailingStore) AcquireLease(context.Context, string, string, time.Duration) (bool, error) {
+	return false, errStoreDown
+}
+
+func TestSuperviseLeavesLobbyToAdoptionOnLeaseError(t *testing.T) {
+	ctx := t.Context()
+	s := store.NewMemoryStore(&scorer.Ranking{})
+	w := newTestWaiter(t, &leaseFailingStore{LobbyStore: s}, allocator.NewMemoryAllocator())
+
+	l := models.NewLobby("lobby-1", "classic", []*models.Player{{ID: "player-1"}}, time.Minute)
+	require.NoError(t, s.AddLobby(ctx, l))
+
+	stop := w.Supervise(l)
+	defer stop()
+
+	expired, err := s.GetExpiredLeases(ctx, 10)
+	require.NoError(t, err)
+	require.Contains(t, expired, l.ID)
+
+	acquired, err := s.AcquireLease(ctx, l.ID, "peer", time.Minute)
+	require.NoError(t, err)
+	require.True(t, acquired)
+}
@@ -2,65 +2,151 @@ package lobby
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 
 	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/allocator"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/matchmaking"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/stats"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/streamer"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
 var _ abstractions.ConfigSubscriber[*Config] = (*Waiter)(nil)
 
 type Waiter struct {
-	store    *store.Store
-	streamer *streamer.StreamManager
-	logger   *zap.Logger
-	mx       sync.RWMutex
-	cfg      *Config
+	store     store.LobbyStore
+	streamer  *streamer.StreamManager
+	allocator allocator.GameAllocator
+	finder    *matchmaking.Finder
+	stats     *stats.Collector
+	logger    *zap.Logger
+	owner     string
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	mx        sync.RWMutex
+	cfg       *Config
 }
 
-func NewWaiter(store *store.Store, streamer *streamer.StreamManager, logger *zap.Logger, cfg *Config) *Waiter {
+func NewWaiter(
+	store store.LobbyStore,
+	streamer *streamer.StreamManager,
+	allocator allocator.GameAllocator,
+	finder *matchmaking.Finder,
+	stats *stats.Collector,
+	logger *zap.Logger,
+	cfg *Config,
+) *Waiter {
+	ctx, cancel := context.WithCancel(context.Background())
+
 	return &Waiter{
-		store:    store,
-		streamer: streamer,
-		logger:   logger,
-		cfg:      cfg,
+		store:     store,
+		streamer:  streamer,
+		allocator: allocator,
+		finder:    finder,
+		stats:     stats,
+		logger:    logger,
+		owner:     uuid.NewString(),
+		ctx:       ctx,
+		cancel:    cancel,
+		cfg:       cfg,
 	}
 }
 
 func (w *Waiter) WaitForLobbyFill(ctx context.Context, lobby *models.Lobby) {
+	var finished bool
+
 	defer w.cleanupMetrics(lobby)
+	defer func() {
+		// Supervision stopping before the lobby is gone leaves it behind, let a peer pick it up.
+		if !finished {
+			w.handoffLease(lobby.ID)
+			return
+		}
+
+		w.releaseLease(lobby.ID)
+	}()
 
 	w.initMetrics(lobby)
 	ticker := time.NewTicker(w.getTickerTimeout())
 	defer ticker.Stop()
 
+	var allocationAttempts, readFailures int
+	indexedVersion := lobby.Version
+
 	for {
 		select {
 		case <-ticker.C:
+			if !w.renewLease(ctx, lobby.ID) {
+				return
+			}
+
 			updated, err := w.store.GetLobby(ctx, lobby.ID)
+			if errors.Is(err, store.ErrLobbyNotFound) {
+				w.logger.Debug("Lobby no longer stored, stop waiting", zap.String("lobby_id", lobby.ID))
+				finished = true
+				return
+			}
+
 			if err != nil {
-				if err = w.handleState(ctx, StateError, lobby); err != nil {
-					w.logger.Warn("Failed to handle lobby state update",
+				readFailures++
+				if readFailures < w.getMaxReadFailures() {
+					w.logger.Warn("Failed to read lobby, retry on next tick",
 						zap.String("lobby_id", lobby.ID),
-						zap.Error(err),
-					)
+						zap.Int("attempt", readFailures),
+						zap.Error(err))
+					continue
 				}
+
+				w.logger.Error("Failed to read lobby, hand off supervision", zap.String("lobby_id", lobby.ID), zap.Error(err))
 				return
 			}
 
+			readFailures = 0
+
+			if updated.Version != indexedVersion {
+				if err = w.store.IndexLobby(ctx, updated); err != nil {
+					w.logger.Warn("Failed to refresh lobby indexes", zap.String("lobby_id", updated.ID), zap.Error(err))
+				} else {
+					indexedVersion = updated.Version
+				}
+			}
+
 			state := w.determineState(updated)
-			if err = w.handleState(ctx, state, updated); err != nil {
+			err = w.handleState(ctx, state, updated)
+
+			switch {
+			case errors.Is(err, errAllocationFailed):
+				allocationAttempts++
+				if allocationAttempts < w.getMaxAllocationAttempts() {
+					w.logger.Warn("Game allocation failed, lobby requeued",
+						zap.String("lobby_id", updated.ID),
+						zap.Int("attempt", allocationAttempts),
+						zap.Error(err))
+					continue
+				}
+
+				w.handleAllocationFailure(ctx, updated, err)
+				finished = true
+				return
+			case errors.Is(err, ErrLobbyStarting):
+				// Another caller is starting the lobby, keep watching in case its allocation fails.
+				continue
+			case err != nil:
 				w.logger.Error("State handling failed",
 					zap.String("state", string(state)),
 					zap.Error(err))
 				return
 			}
 
-			if state != StateWaiting {
+			if !w.keepWaiting(state, updated) {
+				finished = true
 				return
 			}
 
@@ -1,11 +1,39 @@
 package matchmaking
 
-import "github.com/QuizWars-Ecosystem/lobby-service/internal/models/matcher"
+import (
+	"strconv"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/metrics"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/matcher"
+)
 
 func (m *Matcher) SectionKey() string {
 	return m.lobbyScorer.SectionKey()
 }
 
 func (m *Matcher) UpdateConfig(newCfg *matcher.Config) error {
-	return m.lobbyScorer.UpdateConfig(newCfg)
+	if err := m.lobbyScorer.UpdateConfig(newCfg); err != nil {
+		metrics.MatcherConfigReloads.WithLabelValues("rejected").Inc()
+		return err
+	}
+
+	metrics.MatcherConfigReloads.WithLabelValues("applied").Inc()
+	m.reportConfig()
+
+	return nil
+}
+
+// Scoring returns the applied matcher config version and the scoring config of every mode.
+func (m *Matcher) Scoring() matcher.ScoringSnapshot {
+	return m.lobbyScorer.Snapshot()
+}
+
+func (m *Matcher) reportConfig() {
+	snapshot := m.lobbyScorer.Snapshot()
+
+	metrics.MatcherConfigVersion.Set(float64(snapshot.Version))
+	metrics.ModeScoringConfig.Reset()
+	for _, mode := range snapshot.Modes {
+		metrics.ModeScoringConfig.WithLabelValues(mode.Mode, mode.Source, strconv.FormatUint(snapshot.Version, 10)).Set(1)
+	}
 }
@@ -0,0 +1,137 @@
+package matchmaking
+
+import (
+	"context"
+	"math"
+	"slices"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/metrics"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"go.uber.org/zap"
+)
+
+type SearchOptions struct {
+	Limit       int
+	Attempts    int
+	ExcludedIDs []string
+}
+
+type Finder struct {
+	matcher *Matcher
+	store   store.LobbyStore
+	logger  *zap.Logger
+}
+
+func NewFinder(matcher *Matcher, store store.LobbyStore, logger *zap.Logger) *Finder {
+	return &Finder{
+		matcher: matcher,
+		store:   store,
+		logger:  logger,
+	}
+}
+
+func (f *Finder) Matcher() *Matcher {
+	return f.matcher
+}
+
+func (f *Finder) FindLobby(ctx context.Context, modes []string, players []*models.Player, opts SearchOptions) (*models.Lobby, error) {
+	for _, mode := range modes {
+		l, err := f.findLobbyInMode(ctx, mode, players, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		if l != nil {
+			return l, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f *Finder) findLobbyInMode(ctx context.Context, mode string, players []*models.Player, opts SearchOptions) (*models.Lobby, error) {
+	activeLobbies, err := f.store.FindCandidateLobbies(ctx, mode, f.candidateQuery(mode, players), opts.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(activeLobbies) == 0 {
+		if activeLobbies, err = f.store.GetTopLobbies(ctx, mode, opts.Limit); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(activeLobbies) == 0 {
+		return nil, nil
+	}
+
+	excludedLobbies := make(map[string]struct{}, len(opts.ExcludedIDs))
+	for _, id := range opts.ExcludedIDs {
+		excludedLobbies[id] = struct{}{}
+	}
+
+	for attempt := 0; attempt < opts.Attempts; attempt++ {
+		filteredLobbies := make([]*models.Lobby, 0, len(activeLobbies))
+		for _, availableLobby := range activeLobbies {
+			if _, excluded := excludedLobbies[availableLobby.ID]; !excluded {
+				filteredLobbies = append(filteredLobbies, availableLobby)
+			}
+		}
+
+		if len(filteredLobbies) == 0 {
+			break
+		}
+
+		candidateLobbies := f.matcher.FilterLobbies(mode, filteredLobbies, players)
+		selectedLobby := f.matcher.SelectBestLobby(mode, candidateLobbies, players)
+
+		if selectedLobby == nil {
+			break
+		}
+
+		if err = f.store.AddPlayers(ctx, selectedLobby.ID, players...); err != nil {
+			excludedLobbies[selectedLobby.ID] = struct{}{}
+			continue
+		}
+
+		metrics.LobbyPlayersCount.WithLabelValues(selectedLobby.ID, selectedLobby.Mode).Set(float64(len(selectedLobby.Players) + len(players)))
+
+		f.logger.Debug("Lobby was found",
+			zap.String("lobby_id", selectedLobby.ID),
+			zap.String("mode", selectedLobby.Mode),
+		)
+
+		return selectedLobby, nil
+	}
+
+	return nil, nil
+}
+
+// candidateQuery covers the categories of every player and their rating spread
+// widened by the rating window of the mode.
+func (f *Finder) candidateQuery(mode string, players []*models.Player) store.CandidateQuery {
+	var query store.CandidateQuery
+
+	minRating, maxRating := players[0].Rating, players[0].Rating
+	for _, p := range players {
+		minRating = min(minRating, p.Rating)
+		maxRating = max(maxRating, p.Rating)
+
+		for _, category := range p.Categories {
+			if !slices.Contains(query.Categories, category) {
+				query.Categories = append(query.Categories, category)
+			}
+		}
+	}
+
+	window := f.matcher.SearchWindow(mode, players)
+	query.MinRating = clampRating(float64(minRating) - window)
+	query.MaxRating = clampRating(float64(maxRating) + window)
+
+	return query
+}
+
+func clampRating(rating float64) int32 {
+	return int32(max(min(rating, math.MaxInt32), math.MinInt32))
+}
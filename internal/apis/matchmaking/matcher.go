@@ -2,6 +2,7 @@ package matchmaking
 
 import (
 	"math"
+	"time"
 
 	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
@@ -14,18 +15,30 @@ type Matcher struct {
 	lobbyScorer *matcher.LobbyScorer
 }
 
-func NewMatcher(cfg *matcher.Config) *Matcher {
-	return &Matcher{
-		lobbyScorer: matcher.NewLobbyScorer(cfg),
+func NewMatcher(cfg *matcher.Config) (*Matcher, error) {
+	lobbyScorer, err := matcher.NewLobbyScorer(cfg)
+	if err != nil {
+		return nil, err
 	}
+
+	m := &Matcher{
+		lobbyScorer: lobbyScorer,
+	}
+	m.reportConfig()
+
+	return m, nil
 }
 
-func (m *Matcher) FilterLobbies(mode string, lobbies []*models.Lobby, player *models.Player) []*models.Lobby {
+func (m *Matcher) FilterLobbies(mode string, lobbies []*models.Lobby, players []*models.Player) []*models.Lobby {
 	scorer := m.lobbyScorer.GetScorer(mode)
 	result := make([]*models.Lobby, 0, len(lobbies))
 
 	for _, l := range lobbies {
-		if scorer.Filter(l, player) {
+		if l.FreeSeats() < len(players) {
+			continue
+		}
+
+		if filterAll(scorer, l, players) {
 			result = append(result, l)
 		}
 	}
@@ -33,8 +46,8 @@ func (m *Matcher) FilterLobbies(mode string, lobbies []*models.Lobby, player *mo
 	return result
 }
 
-func (m *Matcher) SelectBestLobby(mode string, lobbies []*models.Lobby, player *models.Player) *models.Lobby {
-	if len(lobbies) == 0 {
+func (m *Matcher) SelectBestLobby(mode string, lobbies []*models.Lobby, players []*models.Player) *models.Lobby {
+	if len(lobbies) == 0 || len(players) == 0 {
 		return nil
 	}
 
@@ -45,10 +58,47 @@ func (m *Matcher) SelectBestLobby(mode string, lobbies []*models.Lobby, player *
 	)
 
 	for _, lobby := range lobbies {
-		if score := scorer.Score(lobby, player); score > bestScore {
+		var score float64
+		for _, player := range players {
+			score += scorer.Score(lobby, player)
+		}
+
+		if score /= float64(len(players)); score > bestScore {
 			bestLobby = lobby
 			bestScore = score
 		}
 	}
 	return bestLobby
 }
+
+// SearchWindow returns the rating window of players joining together,
+// widened by the longest wait among them.
+func (m *Matcher) SearchWindow(mode string, players []*models.Player) float64 {
+	var wait time.Duration
+	for _, p := range players {
+		if !p.JoinedAt.IsZero() {
+			wait = max(wait, time.Since(p.JoinedAt))
+		}
+	}
+
+	return m.lobbyScorer.RatingWindow(mode, wait)
+}
+
+func (m *Matcher) RatingWindow(lobby *models.Lobby) float64 {
+	var wait time.Duration
+	if oldest := lobby.OldestJoinedAt(); !oldest.IsZero() {
+		wait = time.Since(oldest)
+	}
+
+	return m.lobbyScorer.RatingWindow(lobby.Mode, wait)
+}
+
+func filterAll(scorer matcher.Scorer, lobby *models.Lobby, players []*models.Player) bool {
+	for _, player := range players {
+		if !scorer.Filter(lobby, player) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,38 @@
+package stats
+
+import "time"
+
+type Config struct {
+	SampleSize      int           `mapstructure:"sample_size" yaml:"sample_size" default:"200"`
+	RefreshInterval time.Duration `mapstructure:"refresh_interval" yaml:"refresh_interval" default:"5s"`
+}
+
+func (c *Collector) SectionKey() string {
+	return "STATS"
+}
+
+func (c *Collector) UpdateConfig(newCfg *Config) error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.cfg = newCfg
+	return nil
+}
+
+func (c *Collector) getSampleSize() int {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+	if c.cfg.SampleSize < 10 {
+		return 10
+	}
+	return c.cfg.SampleSize
+}
+
+func (c *Collector) getRefreshInterval() time.Duration {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+	if c.cfg.RefreshInterval < time.Second {
+		return time.Second
+	}
+	return c.cfg.RefreshInterval
+}
@@ -0,0 +1,111 @@
+package stats
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"go.uber.org/zap"
+)
+
+var _ abstractions.ConfigSubscriber[*Config] = (*Collector)(nil)
+
+type QueueStats struct {
+	Mode           string
+	PlayersQueued  int
+	LobbiesWaiting int
+	WaitP50        time.Duration
+	WaitP90        time.Duration
+	WaitP99        time.Duration
+	Samples        int
+	UpdatedAt      time.Time
+}
+
+type Collector struct {
+	store  store.LobbyStore
+	logger *zap.Logger
+	mx     sync.RWMutex
+	cache  map[string]*QueueStats
+	cfg    *Config
+}
+
+func NewCollector(store store.LobbyStore, logger *zap.Logger, cfg *Config) *Collector {
+	return &Collector{
+		store:  store,
+		logger: logger,
+		cache:  make(map[string]*QueueStats),
+		cfg:    cfg,
+	}
+}
+
+func (c *Collector) RecordWaitTime(ctx context.Context, mode string, wait time.Duration) {
+	if err := c.store.RecordWaitTime(ctx, mode, wait, c.getSampleSize()); err != nil {
+		c.logger.Warn("Failed to record wait time", zap.String("mode", mode), zap.Error(err))
+	}
+}
+
+func (c *Collector) Stats(ctx context.Context, mode string) (*QueueStats, error) {
+	refresh := c.getRefreshInterval()
+
+	c.mx.RLock()
+	cached, ok := c.cache[mode]
+	c.mx.RUnlock()
+
+	if ok && time.Since(cached.UpdatedAt) < refresh {
+		return cached, nil
+	}
+
+	lobbies, players, err := c.store.GetQueueSize(ctx, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	waits, err := c.store.GetWaitTimes(ctx, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(waits, func(i, j int) bool {
+		return waits[i] < waits[j]
+	})
+
+	stats := &QueueStats{
+		Mode:           mode,
+		PlayersQueued:  players,
+		LobbiesWaiting: lobbies,
+		WaitP50:        percentile(waits, 0.5),
+		WaitP90:        percentile(waits, 0.9),
+		WaitP99:        percentile(waits, 0.99),
+		Samples:        len(waits),
+		UpdatedAt:      time.Now(),
+	}
+
+	c.mx.Lock()
+	c.cache[mode] = stats
+	c.mx.Unlock()
+
+	return stats, nil
+}
+
+func (s *QueueStats) EstimateWait(waited time.Duration) time.Duration {
+	switch {
+	case waited < s.WaitP50:
+		return s.WaitP50 - waited
+	case waited < s.WaitP90:
+		return s.WaitP90 - waited
+	default:
+		return 0
+	}
+}
+
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	idx := int(p * float64(len(sorted)-1))
+	return sorted[idx]
+}
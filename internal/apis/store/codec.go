@@ -0,0 +1,223 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+)
+
+const (
+	CodecJSON   = "json"
+	CodecBinary = "binary"
+)
+
+// binaryCodecV1 prefixes binary lobby values, JSON values always start with '{'.
+const binaryCodecV1 byte = 0x01
+
+var ErrUnknownEncoding = errors.New("unknown lobby encoding")
+
+// Codec encodes lobbies stored in Redis. Decoding detects the encoding of the value,
+// so instances writing different encodings can share the same keys during rollout.
+type Codec interface {
+	Marshal(lobby *models.Lobby) ([]byte, error)
+	Unmarshal(data []byte, lobby *models.Lobby) error
+}
+
+// NewCodec returns the binary codec only when asked for, instances still reading JSON only
+// can not decode its values.
+func NewCodec(name string) Codec {
+	if name == CodecBinary {
+		return BinaryCodec{}
+	}
+
+	return JSONCodec{}
+}
+
+type JSONCodec struct{}
+
+func (JSONCodec) Marshal(lobby *models.Lobby) ([]byte, error) {
+	return json.Marshal(lobby)
+}
+
+func (JSONCodec) Unmarshal(data []byte, lobby *models.Lobby) error {
+	return unmarshalLobby(data, lobby)
+}
+
+// BinaryCodec writes lobbies as MessagePack maps behind a version byte. Keys are the JSON
+// field names and times are unix microseconds, so the join script can decode, change and
+// encode a lobby with cmsgpack without losing precision in Lua numbers.
+type BinaryCodec struct{}
+
+func (BinaryCodec) Marshal(lobby *models.Lobby) ([]byte, error) {
+	b := make([]byte, 0, 256+len(lobby.Players)*96)
+	b = append(b, binaryCodecV1)
+
+	return appendLobby(b, lobby), nil
+}
+
+func (BinaryCodec) Unmarshal(data []byte, lobby *models.Lobby) error {
+	return unmarshalLobby(data, lobby)
+}
+
+func unmarshalLobby(data []byte, lobby *models.Lobby) error {
+	switch {
+	case len(data) == 0:
+		return ErrUnknownEncoding
+	case data[0] == '{':
+		return json.Unmarshal(data, lobby)
+	case data[0] == binaryCodecV1:
+		return unmarshalBinaryLobby(data[1:], lobby)
+	default:
+		return fmt.Errorf("%w: version %d", ErrUnknownEncoding, data[0])
+	}
+}
+
+// marshalBinaryPlayers encodes players the way they are stored inside a binary lobby.
+func marshalBinaryPlayers(players []*models.Player) []byte {
+	b := msgpAppendArrayLen(make([]byte, 0, len(players)*96), len(players))
+	for _, p := range players {
+		b = appendPlayer(b, p)
+	}
+
+	return b
+}
+
+func appendLobby(b []byte, lobby *models.Lobby) []byte {
+	m := msgpBeginMap(b)
+	m.string("id", lobby.ID)
+	m.string("mode", lobby.Mode)
+	if len(lobby.Categories) > 0 {
+		m.b = msgpAppendInt32s(m.key("categories"), lobby.Categories)
+	}
+	if len(lobby.Players) > 0 {
+		m.b = msgpAppendArrayLen(m.key("players"), len(lobby.Players))
+		for _, p := range lobby.Players {
+			m.b = appendPlayer(m.b, p)
+		}
+	}
+	m.int("min_players", int64(lobby.MinPlayers))
+	m.int("max_players", int64(lobby.MaxPlayers))
+	m.int("teams", int64(lobby.Teams))
+	m.int("avg_rating", int64(lobby.AvgRating))
+	m.time("created_at", lobby.CreatedAt)
+	m.time("last_joined_at", lobby.LastJoinedAt)
+	m.time("expire_at", lobby.ExpireAt)
+	m.int("version", int64(lobby.Version))
+	if lobby.Private {
+		m.b = msgpAppendBool(m.key("private"), true)
+	}
+	m.string("host_id", lobby.HostID)
+	m.string("code", lobby.Code)
+	m.int("ready_check", int64(lobby.ReadyCheck))
+	m.time("ready_check_started_at", lobby.ReadyCheckStartedAt)
+	if len(lobby.Accepted) > 0 {
+		m.b = msgpAppendMapLen(m.key("accepted"), len(lobby.Accepted))
+		for id, accepted := range lobby.Accepted {
+			m.b = msgpAppendBool(msgpAppendString(m.b, id), accepted)
+		}
+	}
+
+	return m.end()
+}
+
+func appendPlayer(b []byte, p *models.Player) []byte {
+	m := msgpBeginMap(b)
+	m.b = msgpAppendString(m.key("id"), p.ID)
+	m.b = msgpAppendInt(m.key("rating"), int64(p.Rating))
+	if len(p.Categories) > 0 {
+		m.b = msgpAppendInt32s(m.key("categories"), p.Categories)
+	}
+	m.string("party_id", p.PartyID)
+	m.time("joined_at", p.JoinedAt)
+
+	return m.end()
+}
+
+func unmarshalBinaryLobby(b []byte, lobby *models.Lobby) error {
+	*lobby = models.Lobby{}
+
+	r := &msgpReader{b: b}
+	r.readMap(func(key []byte) {
+		switch string(key) {
+		case "id":
+			lobby.ID = r.readString()
+		case "mode":
+			lobby.Mode = r.readString()
+		case "categories":
+			lobby.Categories = r.readInt32s()
+		case "players":
+			n := r.readArrayLen()
+			lobby.Players = make([]*models.Player, 0, n)
+			for i := 0; i < n && r.err == nil; i++ {
+				lobby.Players = append(lobby.Players, readPlayer(r))
+			}
+		case "min_players":
+			lobby.MinPlayers = int16(r.readInt())
+		case "max_players":
+			lobby.MaxPlayers = int16(r.readInt())
+		case "teams":
+			lobby.Teams = int16(r.readInt())
+		case "avg_rating":
+			lobby.AvgRating = int32(r.readInt())
+		case "created_at":
+			lobby.CreatedAt = r.readTime()
+		case "last_joined_at":
+			lobby.LastJoinedAt = r.readTime()
+		case "expire_at":
+			lobby.ExpireAt = r.readTime()
+		case "version":
+			lobby.Version = int16(r.readInt())
+		case "private":
+			lobby.Private = r.readBool()
+		case "host_id":
+			lobby.HostID = r.readString()
+		case "code":
+			lobby.Code = r.readString()
+		case "ready_check":
+			lobby.ReadyCheck = time.Duration(r.readInt())
+		case "ready_check_started_at":
+			lobby.ReadyCheckStartedAt = r.readTime()
+		case "accepted":
+			r.readMap(func(id []byte) {
+				if lobby.Accepted == nil {
+					lobby.Accepted = make(map[string]bool)
+				}
+				lobby.Accepted[string(id)] = r.readBool()
+			})
+		default:
+			r.skip()
+		}
+	})
+
+	if r.err != nil {
+		return fmt.Errorf("%w: %w", ErrUnknownEncoding, r.err)
+	}
+
+	return nil
+}
+
+func readPlayer(r *msgpReader) *models.Player {
+	var p models.Player
+
+	r.readMap(func(key []byte) {
+		switch string(key) {
+		case "id":
+			p.ID = r.readString()
+		case "rating":
+			p.Rating = int32(r.readInt())
+		case "categories":
+			p.Categories = r.readInt32s()
+		case "party_id":
+			p.PartyID = r.readString()
+		case "joined_at":
+			p.JoinedAt = r.readTime()
+		default:
+			r.skip()
+		}
+	})
+
+	return &p
+}
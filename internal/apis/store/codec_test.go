@@ -0,0 +1,116 @@
+package store_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/stretchr/testify/require"
+)
+
+func newCodecLobby(players int) *models.Lobby {
+	list := make([]*models.Player, players)
+	for i := range list {
+		list[i] = &models.Player{
+			ID:         "player-" + strconv.Itoa(i),
+			Rating:     int32(1000 + i*7),
+			Categories: []int32{int32(i % 25), int32(i % 7)},
+			JoinedAt:   time.Now().Add(-time.Duration(i) * time.Second),
+		}
+	}
+	list[0].PartyID = "party-1"
+
+	lobby := models.NewLobby("lobby-1", "mega", list, time.Minute)
+	lobby.MinPlayers = 64
+	lobby.MaxPlayers = 128
+	lobby.Teams = 4
+	lobby.LastJoinedAt = time.Now()
+	lobby.StartReadyCheck()
+	lobby.ReadyCheck = time.Second * 15
+	lobby.AcceptMatch(list[1].ID)
+
+	return lobby
+}
+
+func TestCodecs(t *testing.T) {
+	lobby := newCodecLobby(128)
+
+	for _, codec := range []store.Codec{store.JSONCodec{}, store.BinaryCodec{}} {
+		data, err := codec.Marshal(lobby)
+		require.NoError(t, err)
+
+		for _, reader := range []store.Codec{store.JSONCodec{}, store.BinaryCodec{}} {
+			var decoded models.Lobby
+			require.NoError(t, reader.Unmarshal(data, &decoded))
+
+			require.Equal(t, lobby.ID, decoded.ID)
+			require.Equal(t, lobby.Categories, decoded.Categories)
+			require.Equal(t, lobby.Version, decoded.Version)
+			require.Equal(t, lobby.Teams, decoded.Teams)
+			require.Equal(t, lobby.ReadyCheck, decoded.ReadyCheck)
+			require.Equal(t, lobby.Accepted, decoded.Accepted)
+			require.WithinDuration(t, lobby.ExpireAt, decoded.ExpireAt, time.Microsecond)
+			require.WithinDuration(t, lobby.ReadyCheckStartedAt, decoded.ReadyCheckStartedAt, time.Microsecond)
+			require.Len(t, decoded.Players, len(lobby.Players))
+
+			for i, p := range lobby.Players {
+				require.Equal(t, p.ID, decoded.Players[i].ID)
+				require.Equal(t, p.Rating, decoded.Players[i].Rating)
+				require.Equal(t, p.Categories, decoded.Players[i].Categories)
+				require.Equal(t, p.PartyID, decoded.Players[i].PartyID)
+				require.WithinDuration(t, p.JoinedAt, decoded.Players[i].JoinedAt, time.Microsecond)
+			}
+		}
+	}
+
+	var decoded models.Lobby
+	require.ErrorIs(t, store.BinaryCodec{}.Unmarshal([]byte{0x7f, 0x01}, &decoded), store.ErrUnknownEncoding)
+}
+
+func TestBinaryCodecReadsEmptyLobby(t *testing.T) {
+	data, err := store.BinaryCodec{}.Marshal(&models.Lobby{ID: "empty"})
+	require.NoError(t, err)
+
+	var decoded models.Lobby
+	require.NoError(t, store.BinaryCodec{}.Unmarshal(data, &decoded))
+	require.Equal(t, models.Lobby{ID: "empty"}, decoded)
+}
+
+func BenchmarkCodecs(b *testing.B) {
+	lobby := newCodecLobby(128)
+
+	for _, codec := range []struct {
+		name  string
+		codec store.Codec
+	}{
+		{"JSON", store.JSONCodec{}},
+		{"Binary", store.BinaryCodec{}},
+	} {
+		data, err := codec.codec.Marshal(lobby)
+		require.NoError(b, err)
+
+		b.Run(codec.name+"/Marshal", func(b *testing.B) {
+			b.ReportAllocs()
+			b.ReportMetric(float64(len(data)), "bytes")
+
+			for b.Loop() {
+				if _, err := codec.codec.Marshal(lobby); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+
+		b.Run(codec.name+"/Unmarshal", func(b *testing.B) {
+			b.ReportAllocs()
+
+			for b.Loop() {
+				var decoded models.Lobby
+				if err := codec.codec.Unmarshal(data, &decoded); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const (
+	categoryLobbyKey    = "lobby:category:{%s}:%d"
+	ratingLobbyKey      = "lobby:rating:{%s}:%d"
+	candidatesLobbyKey  = "lobby:candidates:{%s}:%s"
+	indexedLobbyKey     = "lobby:indexed:{%s}"
+	indexedKeySeparator = ","
+)
+
+// RatingBucketSize is the width of the rating ranges lobbies are indexed by,
+// candidates are searched in every bucket overlapping the query rating range.
+const RatingBucketSize = 250
+
+// maxCandidateBuckets bounds the keys of one candidate lookup, wider rating ranges
+// are served from the active set of the mode.
+const maxCandidateBuckets = 32
+
+// CandidateQuery selects lobbies sharing one of the categories
+// or having an average rating between MinRating and MaxRating.
+type CandidateQuery struct {
+	Categories []int32
+	MinRating  int32
+	MaxRating  int32
+}
+
+// candidateLobbiesScript unions index sets of one mode, which share a hash slot,
+// and returns the best scored lobby ids.
+var candidateLobbiesScript = redis.NewScript(`
+local args = {"ZUNIONSTORE", KEYS[1], #KEYS - 1}
+for i = 2, #KEYS do
+	table.insert(args, KEYS[i])
+end
+table.insert(args, "AGGREGATE")
+table.insert(args, "MAX")
+
+redis.call(unpack(args))
+local ids = redis.call("ZREVRANGE", KEYS[1], 0, tonumber(ARGV[1]) - 1)
+redis.call("DEL", KEYS[1])
+
+return ids
+`)
+
+// FindCandidateLobbies returns lobbies of the mode matching the query, best scored first.
+func (s *Store) FindCandidateLobbies(ctx context.Context, mode string, query CandidateQuery, limit int) ([]*models.Lobby, error) {
+	keys, ok := candidateIndexKeys(mode, query)
+	if !ok {
+		return s.GetTopLobbies(ctx, mode, limit)
+	}
+
+	ids, err := candidateLobbiesScript.Run(ctx, s.db,
+		append([]string{fmt.Sprintf(candidatesLobbyKey, mode, uuid.NewString())}, keys...),
+		limit,
+	).StringSlice()
+	if err != nil {
+		s.logger.Error("Failed to find candidate lobbies", zap.String("mode", mode), zap.Error(err))
+		return nil, err
+	}
+
+	return s.loadLobbiesByIDs(ctx, mode, ids, true, keys...)
+}
+
+// updateIndexes moves the lobby to the category and rating sets it currently belongs to
+// and drops it from the sets it has left. The sets are shared by lobbies of the mode and
+// never expire, members of expired lobbies are pruned when a lookup misses them.
+func (s *Store) updateIndexes(ctx context.Context, lobby *models.Lobby, score float64, ttl time.Duration) error {
+	keys := lobbyIndexKeys(lobby)
+
+	pipe := s.db.Pipeline()
+	for _, key := range keys {
+		pipe.ZAdd(ctx, key, redis.Z{Score: score, Member: lobby.ID})
+	}
+	previous := pipe.SetArgs(ctx, fmt.Sprintf(indexedLobbyKey, lobby.ID), strings.Join(keys, indexedKeySeparator), redis.SetArgs{
+		TTL: ttl,
+		Get: true,
+	})
+
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+
+	var stale []string
+	for _, key := range splitIndexedKeys(previous.Val()) {
+		if !slices.Contains(keys, key) {
+			stale = append(stale, key)
+		}
+	}
+
+	return s.removeFromIndexes(ctx, lobby.ID, stale)
+}
+
+// dropIndexes removes the lobby from every set it was indexed in.
+func (s *Store) dropIndexes(ctx context.Context, lobbyID string) error {
+	indexed, err := s.db.GetDel(ctx, fmt.Sprintf(indexedLobbyKey, lobbyID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return s.removeFromIndexes(ctx, lobbyID, splitIndexedKeys(indexed))
+}
+
+func (s *Store) removeFromIndexes(ctx context.Context, lobbyID string, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	pipe := s.db.Pipeline()
+	for _, key := range keys {
+		pipe.ZRem(ctx, key, lobbyID)
+	}
+
+	_, err := pipe.Exec(ctx)
+
+	return err
+}
+
+func lobbyIndexKeys(lobby *models.Lobby) []string {
+	keys := make([]string, 0, len(lobby.Categories)+1)
+	for _, category := range lobby.Categories {
+		keys = append(keys, fmt.Sprintf(categoryLobbyKey, lobby.Mode, category))
+	}
+
+	return append(keys, fmt.Sprintf(ratingLobbyKey, lobby.Mode, ratingBucket(lobby.AvgRating)))
+}
+
+// candidateIndexKeys returns the sets to search for the query, false means the rating
+// range spans too many buckets to be searched by index.
+func candidateIndexKeys(mode string, query CandidateQuery) ([]string, bool) {
+	first, last := ratingBucket(query.MinRating), ratingBucket(query.MaxRating)
+	if last-first >= maxCandidateBuckets {
+		return nil, false
+	}
+
+	keys := make([]string, 0, len(query.Categories)+int(last-first)+1)
+	for _, category := range query.Categories {
+		keys = append(keys, fmt.Sprintf(categoryLobbyKey, mode, category))
+	}
+
+	for b := first; b <= last; b++ {
+		keys = append(keys, fmt.Sprintf(ratingLobbyKey, mode, b))
+	}
+
+	return keys, true
+}
+
+// isCandidateLobby mirrors the index lookup for stores without secondary indexes.
+func isCandidateLobby(lobby *models.Lobby, query CandidateQuery) bool {
+	if bucket := ratingBucket(lobby.AvgRating); bucket >= ratingBucket(query.MinRating) && bucket <= ratingBucket(query.MaxRating) {
+		return true
+	}
+
+	for _, category := range query.Categories {
+		if slices.Contains(lobby.Categories, category) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ratingBucket(rating int32) int32 {
+	return rating / RatingBucketSize
+}
+
+func splitIndexedKeys(value string) []string {
+	if value == "" {
+		return nil
+	}
+
+	return strings.Split(value, indexedKeySeparator)
+}
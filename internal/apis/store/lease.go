@@ -0,0 +1,105 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var renewLeaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseOwnedScript deletes a key only while it still holds the owner value.
+var releaseOwnedScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// AcquireLease takes lobby supervision for owner unless another instance holds it.
+func (s *Store) AcquireLease(ctx context.Context, lobbyID, owner string, ttl time.Duration) (bool, error) {
+	ok, err := s.db.SetNX(ctx, fmt.Sprintf(lobbyLeaseKey, lobbyID), owner, ttl).Result()
+	if err != nil || !ok {
+		return false, err
+	}
+
+	return true, s.trackLease(ctx, lobbyID, ttl)
+}
+
+// RenewLease extends the lease, false means owner lost it to another instance.
+func (s *Store) RenewLease(ctx context.Context, lobbyID, owner string, ttl time.Duration) (bool, error) {
+	renewed, err := renewLeaseScript.Run(ctx, s.db,
+		[]string{fmt.Sprintf(lobbyLeaseKey, lobbyID)},
+		owner, ttl.Milliseconds(),
+	).Int()
+	if err != nil || renewed == 0 {
+		return false, err
+	}
+
+	return true, s.trackLease(ctx, lobbyID, ttl)
+}
+
+func (s *Store) ReleaseLease(ctx context.Context, lobbyID, owner string) error {
+	released, err := releaseOwnedScript.Run(ctx, s.db,
+		[]string{fmt.Sprintf(lobbyLeaseKey, lobbyID)},
+		owner,
+	).Int()
+	if err != nil || released == 0 {
+		return err
+	}
+
+	return s.db.ZRem(ctx, lobbyLeasesKey, lobbyID).Err()
+}
+
+// HandoffLease drops the lease but keeps the lobby tracked, so peers adopt it on their next scan.
+func (s *Store) HandoffLease(ctx context.Context, lobbyID, owner string) error {
+	released, err := releaseOwnedScript.Run(ctx, s.db,
+		[]string{fmt.Sprintf(lobbyLeaseKey, lobbyID)},
+		owner,
+	).Int()
+	if err != nil || released == 0 {
+		return err
+	}
+
+	return s.db.ZAdd(ctx, lobbyLeasesKey, redis.Z{Score: 0, Member: lobbyID}).Err()
+}
+
+// OrphanLease tracks the lobby as expired whoever holds its lease, so peers adopt it once no lease is held.
+func (s *Store) OrphanLease(ctx context.Context, lobbyID string) error {
+	return s.db.ZAdd(ctx, lobbyLeasesKey, redis.Z{Score: 0, Member: lobbyID}).Err()
+}
+
+// GetExpiredLeases returns lobbies whose supervisor stopped renewing its lease.
+func (s *Store) GetExpiredLeases(ctx context.Context, limit int) ([]string, error) {
+	return s.db.ZRangeByScore(ctx, lobbyLeasesKey, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(time.Now().UnixMilli(), 10),
+		Count: int64(limit),
+	}).Result()
+}
+
+func (s *Store) trackLease(ctx context.Context, lobbyID string, ttl time.Duration) error {
+	return s.db.ZAdd(ctx, lobbyLeasesKey, redis.Z{
+		Score:  float64(time.Now().Add(ttl).UnixMilli()),
+		Member: lobbyID,
+	}).Err()
+}
+
+// ClaimLobbyStart lets a single caller start the lobby, the claim expires after ttl,
+// so a started lobby can not be claimed again while stale readers still see it.
+func (s *Store) ClaimLobbyStart(ctx context.Context, lobbyID, owner string, ttl time.Duration) (bool, error) {
+	return s.db.SetNX(ctx, fmt.Sprintf(lobbyStartKey, lobbyID), owner, ttl).Result()
+}
+
+// ReleaseLobbyStart drops the claim of a failed start, so the lobby can be started again.
+func (s *Store) ReleaseLobbyStart(ctx context.Context, lobbyID, owner string) error {
+	return releaseOwnedScript.Run(ctx, s.db, []string{fmt.Sprintf(lobbyStartKey, lobbyID)}, owner).Err()
+}
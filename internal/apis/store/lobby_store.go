@@ -0,0 +1,47 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+)
+
+type LobbyStore interface {
+	AddLobby(ctx context.Context, lobby *models.Lobby) error
+	GetLobby(ctx context.Context, lobbyID string) (*models.Lobby, error)
+	GetLobbies(ctx context.Context, mode string) ([]*models.Lobby, error)
+	GetTopLobbies(ctx context.Context, mode string, limit int) ([]*models.Lobby, error)
+	FindCandidateLobbies(ctx context.Context, mode string, query CandidateQuery, limit int) ([]*models.Lobby, error)
+	GetLobbiesByScore(ctx context.Context, mode string, min, max float64) ([]*models.Lobby, error)
+	ListLobbies(ctx context.Context, mode string, offset, limit int) ([]*models.Lobby, int64, error)
+	AddPlayer(ctx context.Context, lobbyID string, player *models.Player) error
+	AddPlayers(ctx context.Context, lobbyID string, players ...*models.Player) error
+	RemovePlayer(ctx context.Context, lobbyID, playerID string) (*models.Lobby, error)
+	UpdateLobby(ctx context.Context, lobbyID string, update func(lobby *models.Lobby) error) (*models.Lobby, error)
+	MergeLobbies(ctx context.Context, sourceID, targetID string, compatible func(source, target *models.Lobby) bool) (*models.Lobby, *models.Lobby, error)
+	AtomicUpdateLobby(ctx context.Context, lobby *models.Lobby) error
+	IndexLobby(ctx context.Context, lobby *models.Lobby) error
+	RemoveLobby(ctx context.Context, lobbyID, mode string) error
+	AddPrivateLobby(ctx context.Context, lobby *models.Lobby) error
+	GetLobbyByCode(ctx context.Context, code string) (*models.Lobby, error)
+	SetPartyLobby(ctx context.Context, partyID, lobbyID string, ttl time.Duration) error
+	GetPartyLobby(ctx context.Context, partyID string) (string, error)
+	RecordWaitTime(ctx context.Context, mode string, wait time.Duration, size int) error
+	GetWaitTimes(ctx context.Context, mode string) ([]time.Duration, error)
+	GetQueueSize(ctx context.Context, mode string) (lobbies, players int, err error)
+	ClaimSeat(ctx context.Context, playerID string, seat *models.Seat, ttl time.Duration) (bool, error)
+	SetSeat(ctx context.Context, playerID string, seat *models.Seat, ttl time.Duration) error
+	GetSeat(ctx context.Context, playerID string) (*models.Seat, error)
+	RemoveSeat(ctx context.Context, playerID, lobbyID string) error
+	DetachSeat(ctx context.Context, playerID, sessionID string) error
+	ResumeSeat(ctx context.Context, playerID string, seat *models.Seat, ttl time.Duration) (bool, error)
+	AcquireLease(ctx context.Context, lobbyID, owner string, ttl time.Duration) (bool, error)
+	RenewLease(ctx context.Context, lobbyID, owner string, ttl time.Duration) (bool, error)
+	ReleaseLease(ctx context.Context, lobbyID, owner string) error
+	HandoffLease(ctx context.Context, lobbyID, owner string) error
+	OrphanLease(ctx context.Context, lobbyID string) error
+	GetExpiredLeases(ctx context.Context, limit int) ([]string, error)
+	ClaimLobbyStart(ctx context.Context, lobbyID, owner string, ttl time.Duration) (bool, error)
+	ReleaseLobbyStart(ctx context.Context, lobbyID, owner string) error
+}
@@ -0,0 +1,728 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/matcher"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
+)
+
+var _ LobbyStore = (*MemoryStore)(nil)
+
+type memoryValue struct {
+	data     []byte
+	expireAt time.Time
+}
+
+func (v memoryValue) expired(now time.Time) bool {
+	return !v.expireAt.IsZero() && !now.Before(v.expireAt)
+}
+
+type MemoryStore struct {
+	mu         sync.Mutex
+	lobbies    map[string]memoryValue
+	versions   map[string]int16
+	active     map[string]map[string]float64
+	codes      map[string]memoryValue
+	parties    map[string]memoryValue
+	waits      map[string][]time.Duration
+	seats      map[string]memoryValue
+	leases     map[string]memoryValue
+	leaseIndex map[string]time.Time
+	starts     map[string]memoryValue
+	locks      map[string]*sync.Mutex
+	ranking    *scorer.Ranking
+}
+
+func NewMemoryStore(ranking *scorer.Ranking) *MemoryStore {
+	return &MemoryStore{
+		lobbies:    make(map[string]memoryValue),
+		versions:   make(map[string]int16),
+		active:     make(map[string]map[string]float64),
+		codes:      make(map[string]memoryValue),
+		parties:    make(map[string]memoryValue),
+		waits:      make(map[string][]time.Duration),
+		seats:      make(map[string]memoryValue),
+		leases:     make(map[string]memoryValue),
+		leaseIndex: make(map[string]time.Time),
+		starts:     make(map[string]memoryValue),
+		locks:      make(map[string]*sync.Mutex),
+		ranking:    ranking,
+	}
+}
+
+func (s *MemoryStore) AddLobby(_ context.Context, lobby *models.Lobby) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.saveLobby(lobby)
+}
+
+func (s *MemoryStore) GetLobby(_ context.Context, lobbyID string) (*models.Lobby, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.loadLobby(lobbyID)
+}
+
+func (s *MemoryStore) GetLobbies(_ context.Context, mode string) ([]*models.Lobby, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := s.rangeActive(mode, false)
+
+	return s.loadLobbies(mode, ids, true), nil
+}
+
+func (s *MemoryStore) GetTopLobbies(_ context.Context, mode string, limit int) ([]*models.Lobby, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := s.rangeActive(mode, true)
+	if limit >= 0 && len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	return s.loadLobbies(mode, ids, true), nil
+}
+
+func (s *MemoryStore) FindCandidateLobbies(_ context.Context, mode string, query CandidateQuery, limit int) ([]*models.Lobby, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]string, 0, limit)
+	for _, id := range s.rangeActive(mode, true) {
+		if len(ids) == limit {
+			break
+		}
+
+		if lobby, err := s.loadLobby(id); err == nil && isCandidateLobby(lobby, query) {
+			ids = append(ids, id)
+		}
+	}
+
+	return s.loadLobbies(mode, ids, true), nil
+}
+
+func (s *MemoryStore) GetLobbiesByScore(_ context.Context, mode string, min, max float64) ([]*models.Lobby, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]string, 0)
+	for _, id := range s.rangeActive(mode, false) {
+		if score := s.active[mode][id]; score >= min && score <= max {
+			ids = append(ids, id)
+		}
+	}
+
+	return s.loadLobbies(mode, ids, true), nil
+}
+
+func (s *MemoryStore) ListLobbies(_ context.Context, mode string, offset, limit int) ([]*models.Lobby, int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// private lobbies are not active, so every stored lobby of the mode is listed
+	lobbies := make([]*models.Lobby, 0, len(s.lobbies))
+	for id := range s.lobbies {
+		if lobby, err := s.loadLobby(id); err == nil && lobby.Mode == mode {
+			lobbies = append(lobbies, lobby)
+		}
+	}
+
+	sort.Slice(lobbies, func(i, j int) bool {
+		if !lobbies[i].CreatedAt.Equal(lobbies[j].CreatedAt) {
+			return lobbies[i].CreatedAt.Before(lobbies[j].CreatedAt)
+		}
+		return lobbies[i].ID < lobbies[j].ID
+	})
+
+	total := int64(len(lobbies))
+	if offset >= len(lobbies) {
+		return []*models.Lobby{}, total, nil
+	}
+
+	lobbies = lobbies[offset:]
+	if len(lobbies) > limit {
+		lobbies = lobbies[:limit]
+	}
+
+	return lobbies, total, nil
+}
+
+func (s *MemoryStore) AddPlayer(ctx context.Context, lobbyID string, player *models.Player) error {
+	return s.AddPlayers(ctx, lobbyID, player)
+}
+
+func (s *MemoryStore) AddPlayers(ctx context.Context, lobbyID string, players ...*models.Player) error {
+	_, err := s.UpdateLobby(ctx, lobbyID, func(lobby *models.Lobby) error {
+		if s.starting(lobbyID) {
+			return ErrLobbyStarting
+		}
+
+		if ok := lobby.AddPlayers(players...); !ok {
+			return ErrLobbyFull
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+func (s *MemoryStore) RemovePlayer(ctx context.Context, lobbyID, playerID string) (*models.Lobby, error) {
+	lobby, err := s.UpdateLobby(ctx, lobbyID, func(lobby *models.Lobby) error {
+		if ok := lobby.RemovePlayer(playerID); !ok {
+			return ErrPlayerNotInLobby
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return lobby, s.RemoveSeat(ctx, playerID, lobbyID)
+}
+
+func (s *MemoryStore) UpdateLobby(ctx context.Context, lobbyID string, update func(lobby *models.Lobby) error) (*models.Lobby, error) {
+	unlock := s.lock(lobbyID)
+	defer unlock()
+
+	lobby, err := s.GetLobby(ctx, lobbyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = update(lobby); err != nil {
+		return nil, err
+	}
+
+	if err = s.AtomicUpdateLobby(ctx, lobby); err != nil {
+		return nil, err
+	}
+
+	return lobby, nil
+}
+
+func (s *MemoryStore) MergeLobbies(
+	ctx context.Context,
+	sourceID, targetID string,
+	compatible func(source, target *models.Lobby) bool,
+) (*models.Lobby, *models.Lobby, error) {
+	if sourceID == targetID {
+		return nil, nil, ErrLobbiesMismatch
+	}
+
+	first, second := sourceID, targetID
+	if second < first {
+		first, second = second, first
+	}
+
+	unlockFirst := s.lock(first)
+	defer unlockFirst()
+
+	unlockSecond := s.lock(second)
+	defer unlockSecond()
+
+	source, err := s.GetLobby(ctx, sourceID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	target, err := s.GetLobby(ctx, targetID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if source.Mode != target.Mode || !compatible(source, target) {
+		return nil, nil, ErrLobbiesMismatch
+	}
+
+	if ok := target.AddPlayers(source.Players...); !ok {
+		return nil, nil, ErrLobbyFull
+	}
+
+	if err = s.AtomicUpdateLobby(ctx, target); err != nil {
+		return nil, nil, err
+	}
+
+	if err = s.RemoveLobby(ctx, source.ID, source.Mode); err != nil {
+		return nil, nil, err
+	}
+
+	return source, target, nil
+}
+
+func (s *MemoryStore) AtomicUpdateLobby(_ context.Context, lobby *models.Lobby) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.saveLobby(lobby)
+}
+
+// IndexLobby has nothing to repair, saving a lobby indexes it in the same step.
+func (s *MemoryStore) IndexLobby(context.Context, *models.Lobby) error {
+	return nil
+}
+
+func (s *MemoryStore) RemoveLobby(_ context.Context, lobbyID, mode string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if lobby, err := s.loadLobby(lobbyID); err == nil {
+		for _, p := range lobby.Players {
+			if seat, err := s.loadSeat(p.ID); err == nil && seat.LobbyID == lobbyID {
+				delete(s.seats, p.ID)
+			}
+		}
+	}
+
+	delete(s.lobbies, lobbyID)
+	delete(s.versions, lobbyID)
+	delete(s.active[mode], lobbyID)
+	delete(s.locks, lobbyID)
+
+	return nil
+}
+
+func (s *MemoryStore) AddPrivateLobby(_ context.Context, lobby *models.Lobby) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if code, ok := s.codes[lobby.Code]; ok && !code.expired(time.Now()) {
+		return ErrLobbyCodeTaken
+	}
+
+	s.codes[lobby.Code] = memoryValue{data: []byte(lobby.ID), expireAt: lobby.ExpireAt}
+
+	if err := s.saveLobby(lobby); err != nil {
+		delete(s.codes, lobby.Code)
+		return err
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) GetLobbyByCode(_ context.Context, code string) (*models.Lobby, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.codes[code]
+	if !ok || value.expired(time.Now()) {
+		return nil, ErrLobbyNotFound
+	}
+
+	return s.loadLobby(string(value.data))
+}
+
+func (s *MemoryStore) SetPartyLobby(_ context.Context, partyID, lobbyID string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.parties[partyID] = memoryValue{data: []byte(lobbyID), expireAt: expireAt(ttl)}
+
+	return nil
+}
+
+func (s *MemoryStore) GetPartyLobby(_ context.Context, partyID string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.parties[partyID]
+	if !ok || value.expired(time.Now()) {
+		return "", ErrLobbyNotFound
+	}
+
+	return string(value.data), nil
+}
+
+func (s *MemoryStore) RecordWaitTime(_ context.Context, mode string, wait time.Duration, size int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	waits := append([]time.Duration{wait.Truncate(time.Millisecond)}, s.waits[mode]...)
+	if len(waits) > size {
+		waits = waits[:size]
+	}
+
+	s.waits[mode] = waits
+
+	return nil
+}
+
+func (s *MemoryStore) GetWaitTimes(_ context.Context, mode string) ([]time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]time.Duration{}, s.waits[mode]...), nil
+}
+
+func (s *MemoryStore) GetQueueSize(_ context.Context, mode string) (lobbies, players int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, l := range s.loadLobbies(mode, s.rangeActive(mode, false), false) {
+		if l.InReadyCheck() {
+			continue
+		}
+
+		lobbies++
+		players += len(l.Players)
+	}
+
+	return lobbies, players, nil
+}
+
+func (s *MemoryStore) ClaimSeat(_ context.Context, playerID string, seat *models.Seat, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if value, ok := s.seats[playerID]; ok && !value.expired(time.Now()) {
+		return false, nil
+	}
+
+	return true, s.saveSeat(playerID, seat, ttl)
+}
+
+func (s *MemoryStore) SetSeat(_ context.Context, playerID string, seat *models.Seat, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.saveSeat(playerID, seat, ttl)
+}
+
+func (s *MemoryStore) GetSeat(_ context.Context, playerID string) (*models.Seat, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.loadSeat(playerID)
+}
+
+func (s *MemoryStore) RemoveSeat(_ context.Context, playerID, lobbyID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seat, err := s.loadSeat(playerID)
+	if err != nil {
+		return nil
+	}
+
+	if seat.LobbyID == lobbyID {
+		delete(s.seats, playerID)
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) DetachSeat(_ context.Context, playerID, sessionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seat, err := s.loadSeat(playerID)
+	if err != nil || seat.SessionID != sessionID {
+		return nil
+	}
+
+	seat.Detached = true
+	data, err := json.Marshal(seat)
+	if err != nil {
+		return err
+	}
+
+	s.seats[playerID] = memoryValue{data: data, expireAt: s.seats[playerID].expireAt}
+
+	return nil
+}
+
+func (s *MemoryStore) ResumeSeat(_ context.Context, playerID string, seat *models.Seat, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, err := s.loadSeat(playerID)
+	if err != nil || current.LobbyID != seat.LobbyID || (current.SessionID != "" && !current.Detached) {
+		return false, nil
+	}
+
+	return true, s.saveSeat(playerID, &models.Seat{LobbyID: seat.LobbyID, SessionID: seat.SessionID}, ttl)
+}
+
+func (s *MemoryStore) AcquireLease(_ context.Context, lobbyID, owner string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if lease, ok := s.leases[lobbyID]; ok && !lease.expired(time.Now()) {
+		return false, nil
+	}
+
+	s.leases[lobbyID] = memoryValue{data: []byte(owner), expireAt: expireAt(ttl)}
+	s.leaseIndex[lobbyID] = expireAt(ttl)
+
+	return true, nil
+}
+
+func (s *MemoryStore) RenewLease(_ context.Context, lobbyID, owner string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lease, ok := s.leases[lobbyID]
+	if !ok || lease.expired(time.Now()) || string(lease.data) != owner {
+		return false, nil
+	}
+
+	s.leases[lobbyID] = memoryValue{data: lease.data, expireAt: expireAt(ttl)}
+	s.leaseIndex[lobbyID] = expireAt(ttl)
+
+	return true, nil
+}
+
+func (s *MemoryStore) ReleaseLease(_ context.Context, lobbyID, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lease, ok := s.leases[lobbyID]
+	if !ok || lease.expired(time.Now()) || string(lease.data) != owner {
+		return nil
+	}
+
+	delete(s.leases, lobbyID)
+	delete(s.leaseIndex, lobbyID)
+
+	return nil
+}
+
+func (s *MemoryStore) HandoffLease(_ context.Context, lobbyID, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lease, ok := s.leases[lobbyID]
+	if !ok || lease.expired(time.Now()) || string(lease.data) != owner {
+		return nil
+	}
+
+	delete(s.leases, lobbyID)
+	s.leaseIndex[lobbyID] = time.Time{}
+
+	return nil
+}
+
+func (s *MemoryStore) OrphanLease(_ context.Context, lobbyID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.leaseIndex[lobbyID] = time.Time{}
+
+	return nil
+}
+
+func (s *MemoryStore) GetExpiredLeases(_ context.Context, limit int) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	ids := make([]string, 0)
+	for id, expireAt := range s.leaseIndex {
+		if !now.Before(expireAt) {
+			ids = append(ids, id)
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return s.leaseIndex[ids[i]].Before(s.leaseIndex[ids[j]])
+	})
+
+	if limit >= 0 && len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	return ids, nil
+}
+
+// ClaimLobbyStart holds the lobby lock like a join does, so a claim never lands in the middle of one.
+func (s *MemoryStore) ClaimLobbyStart(_ context.Context, lobbyID, owner string, ttl time.Duration) (bool, error) {
+	unlock := s.lock(lobbyID)
+	defer unlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if value, ok := s.starts[lobbyID]; ok && !value.expired(time.Now()) {
+		return false, nil
+	}
+
+	s.starts[lobbyID] = memoryValue{data: []byte(owner), expireAt: expireAt(ttl)}
+
+	return true, nil
+}
+
+func (s *MemoryStore) starting(lobbyID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.starts[lobbyID]
+	return ok && !value.expired(time.Now())
+}
+
+func (s *MemoryStore) ReleaseLobbyStart(_ context.Context, lobbyID, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if value, ok := s.starts[lobbyID]; ok && string(value.data) == owner {
+		delete(s.starts, lobbyID)
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) lock(lobbyID string) func() {
+	s.mu.Lock()
+	mutex, ok := s.locks[lobbyID]
+	if !ok {
+		mutex = &sync.Mutex{}
+		s.locks[lobbyID] = mutex
+	}
+	s.mu.Unlock()
+
+	mutex.Lock()
+
+	return mutex.Unlock
+}
+
+func (s *MemoryStore) saveLobby(lobby *models.Lobby) error {
+	mode, ok := matcher.LookupMode(lobby.Mode)
+	if !ok {
+		return fmt.Errorf("%w: %s", matcher.ErrUnknownMode, lobby.Mode)
+	}
+
+	if s.versions[lobby.ID] >= lobby.Version {
+		return nil
+	}
+
+	data, err := json.Marshal(lobby)
+	if err != nil {
+		return err
+	}
+
+	s.lobbies[lobby.ID] = memoryValue{data: data, expireAt: lobby.ExpireAt}
+	s.versions[lobby.ID] = lobby.Version
+
+	for _, p := range lobby.Players {
+		seat, err := s.loadSeat(p.ID)
+		if err != nil {
+			seat = &models.Seat{}
+		}
+
+		seat.LobbyID = lobby.ID
+		if err = s.saveSeat(p.ID, seat, time.Until(lobby.ExpireAt)); err != nil {
+			return err
+		}
+	}
+
+	if !lobby.Private {
+		if s.active[lobby.Mode] == nil {
+			s.active[lobby.Mode] = make(map[string]float64)
+		}
+		s.active[lobby.Mode][lobby.ID] = s.ranking.Provider(mode.Name, mode.Provider).CalculateScore(lobby)
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) loadLobby(lobbyID string) (*models.Lobby, error) {
+	value, ok := s.lobbies[lobbyID]
+	if !ok {
+		return nil, ErrLobbyNotFound
+	}
+
+	if value.expired(time.Now()) {
+		delete(s.lobbies, lobbyID)
+		delete(s.versions, lobbyID)
+		return nil, ErrLobbyNotFound
+	}
+
+	var lobby models.Lobby
+	if err := json.Unmarshal(value.data, &lobby); err != nil {
+		return nil, err
+	}
+
+	return &lobby, nil
+}
+
+func (s *MemoryStore) saveSeat(playerID string, seat *models.Seat, ttl time.Duration) error {
+	data, err := json.Marshal(seat)
+	if err != nil {
+		return err
+	}
+
+	s.seats[playerID] = memoryValue{data: data, expireAt: expireAt(ttl)}
+
+	return nil
+}
+
+func (s *MemoryStore) loadSeat(playerID string) (*models.Seat, error) {
+	value, ok := s.seats[playerID]
+	if !ok || value.expired(time.Now()) {
+		return nil, ErrSeatNotFound
+	}
+
+	var seat models.Seat
+	if err := json.Unmarshal(value.data, &seat); err != nil {
+		return nil, err
+	}
+
+	return &seat, nil
+}
+
+func (s *MemoryStore) loadLobbies(mode string, ids []string, joinableOnly bool) []*models.Lobby {
+	lobbies := make([]*models.Lobby, 0, len(ids))
+
+	for _, id := range ids {
+		lobby, err := s.loadLobby(id)
+		if err != nil {
+			delete(s.active[mode], id)
+			continue
+		}
+
+		if !joinableOnly || lobby.CanAddPlayer() {
+			lobbies = append(lobbies, lobby)
+		}
+	}
+
+	return lobbies
+}
+
+func (s *MemoryStore) rangeActive(mode string, reverse bool) []string {
+	scores := s.active[mode]
+	ids := make([]string, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		a, b := ids[i], ids[j]
+		if reverse {
+			a, b = b, a
+		}
+
+		if scores[a] != scores[b] {
+			return scores[a] < scores[b]
+		}
+		return a < b
+	})
+
+	return ids
+}
+
+func expireAt(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+
+	return time.Now().Add(ttl)
+}
@@ -0,0 +1,15 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store/storetest"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
+)
+
+func TestMemoryStore(t *testing.T) {
+	storetest.Run(t, func(_ *testing.T) store.LobbyStore {
+		return store.NewMemoryStore(&scorer.Ranking{})
+	})
+}
@@ -0,0 +1,401 @@
+package store
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// The subset of MessagePack lobbies are stored in. Readers accept every integer, float and
+// string format, since values written back by cmsgpack in Lua pick their own formats, but fail
+// on numbers which are not whole or do not fit an int64 instead of truncating them.
+
+var (
+	errMsgpTruncated = errors.New("msgpack value is truncated")
+	errMsgpOverflow  = errors.New("msgpack number does not fit an int64")
+)
+
+// msgpMap writes a map behind a map16 header which is patched with the field count on end,
+// fields with zero values are left out.
+type msgpMap struct {
+	b      []byte
+	header int
+	n      int
+}
+
+func msgpBeginMap(b []byte) *msgpMap {
+	return &msgpMap{b: append(b, 0xde, 0, 0), header: len(b)}
+}
+
+// key writes a field name and returns the buffer to append its value to.
+func (m *msgpMap) key(name string) []byte {
+	m.n++
+	return msgpAppendString(m.b, name)
+}
+
+func (m *msgpMap) string(name, v string) {
+	if v != "" {
+		m.b = msgpAppendString(m.key(name), v)
+	}
+}
+
+func (m *msgpMap) int(name string, v int64) {
+	if v != 0 {
+		m.b = msgpAppendInt(m.key(name), v)
+	}
+}
+
+// time stores unix microseconds, nanoseconds do not fit the float numbers of Lua.
+func (m *msgpMap) time(name string, t time.Time) {
+	if !t.IsZero() {
+		m.b = msgpAppendInt(m.key(name), t.UnixMicro())
+	}
+}
+
+func (m *msgpMap) end() []byte {
+	binary.BigEndian.PutUint16(m.b[m.header+1:], uint16(m.n))
+	return m.b
+}
+
+func msgpAppendMapLen(b []byte, n int) []byte {
+	switch {
+	case n < 16:
+		return append(b, 0x80|byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, 0xde), uint16(n))
+	default:
+		return binary.BigEndian.AppendUint32(append(b, 0xdf), uint32(n))
+	}
+}
+
+func msgpAppendArrayLen(b []byte, n int) []byte {
+	switch {
+	case n < 16:
+		return append(b, 0x90|byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, 0xdc), uint16(n))
+	default:
+		return binary.BigEndian.AppendUint32(append(b, 0xdd), uint32(n))
+	}
+}
+
+func msgpAppendString(b []byte, s string) []byte {
+	switch n := len(s); {
+	case n < 32:
+		b = append(b, 0xa0|byte(n))
+	case n <= math.MaxUint8:
+		b = append(b, 0xd9, byte(n))
+	case n <= math.MaxUint16:
+		b = binary.BigEndian.AppendUint16(append(b, 0xda), uint16(n))
+	default:
+		b = binary.BigEndian.AppendUint32(append(b, 0xdb), uint32(n))
+	}
+
+	return append(b, s...)
+}
+
+func msgpAppendInt(b []byte, v int64) []byte {
+	switch {
+	case v >= 0 && v < 128:
+		return append(b, byte(v))
+	case v < 0 && v >= -32:
+		return append(b, byte(v))
+	case v >= math.MinInt8 && v <= math.MaxInt8:
+		return append(b, 0xd0, byte(v))
+	case v >= math.MinInt16 && v <= math.MaxInt16:
+		return binary.BigEndian.AppendUint16(append(b, 0xd1), uint16(v))
+	case v >= math.MinInt32 && v <= math.MaxInt32:
+		return binary.BigEndian.AppendUint32(append(b, 0xd2), uint32(v))
+	default:
+		return binary.BigEndian.AppendUint64(append(b, 0xd3), uint64(v))
+	}
+}
+
+func msgpAppendInt32s(b []byte, values []int32) []byte {
+	b = msgpAppendArrayLen(b, len(values))
+	for _, v := range values {
+		b = msgpAppendInt(b, int64(v))
+	}
+
+	return b
+}
+
+func msgpAppendBool(b []byte, v bool) []byte {
+	if v {
+		return append(b, 0xc3)
+	}
+
+	return append(b, 0xc2)
+}
+
+// msgpReader decodes values one by one and keeps the first error, later reads return zero values.
+type msgpReader struct {
+	b   []byte
+	err error
+}
+
+func (r *msgpReader) fail(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+	r.b = nil
+}
+
+func (r *msgpReader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+
+	if len(r.b) < n {
+		r.fail(errMsgpTruncated)
+		return nil
+	}
+
+	v := r.b[:n]
+	r.b = r.b[n:]
+
+	return v
+}
+
+func (r *msgpReader) byte() byte {
+	if v := r.next(1); v != nil {
+		return v[0]
+	}
+
+	return 0
+}
+
+func (r *msgpReader) uint(size int) uint64 {
+	v := r.next(size)
+	if v == nil {
+		return 0
+	}
+
+	switch size {
+	case 1:
+		return uint64(v[0])
+	case 2:
+		return uint64(binary.BigEndian.Uint16(v))
+	case 4:
+		return uint64(binary.BigEndian.Uint32(v))
+	default:
+		return binary.BigEndian.Uint64(v)
+	}
+}
+
+func (r *msgpReader) readMapLen() int {
+	switch t := r.byte(); {
+	case r.err != nil:
+		return 0
+	case t&0xf0 == 0x80:
+		return r.len(uint64(t&0x0f), 2)
+	case t == 0xde:
+		return r.len(r.uint(2), 2)
+	case t == 0xdf:
+		return r.len(r.uint(4), 2)
+	case t == 0x90 || t == 0xc0:
+		// cmsgpack writes empty tables as arrays
+		return 0
+	default:
+		r.fail(fmt.Errorf("msgpack type 0x%x is not a map", t))
+		return 0
+	}
+}
+
+// len checks a map or array header against the bytes left, every value takes at least one byte,
+// so a corrupted header can not make callers allocate for more values than the data holds.
+func (r *msgpReader) len(n uint64, values int) int {
+	if n*uint64(values) > uint64(len(r.b)) {
+		r.fail(errMsgpTruncated)
+		return 0
+	}
+
+	return int(n)
+}
+
+// readMap calls field for every key, which must read or skip the value. The key is only valid
+// during the call.
+func (r *msgpReader) readMap(field func(key []byte)) {
+	n := r.readMapLen()
+	for i := 0; i < n && r.err == nil; i++ {
+		field(r.readBytes())
+	}
+}
+
+func (r *msgpReader) readArrayLen() int {
+	switch t := r.byte(); {
+	case r.err != nil:
+		return 0
+	case t&0xf0 == 0x90:
+		return r.len(uint64(t&0x0f), 1)
+	case t == 0xdc:
+		return r.len(r.uint(2), 1)
+	case t == 0xdd:
+		return r.len(r.uint(4), 1)
+	case t == 0x80 || t == 0xc0:
+		return 0
+	default:
+		r.fail(fmt.Errorf("msgpack type 0x%x is not an array", t))
+		return 0
+	}
+}
+
+func (r *msgpReader) readString() string {
+	return string(r.readBytes())
+}
+
+func (r *msgpReader) readBytes() []byte {
+	var n int
+
+	switch t := r.byte(); {
+	case r.err != nil:
+		return nil
+	case t&0xe0 == 0xa0:
+		n = int(t & 0x1f)
+	case t == 0xd9 || t == 0xc4:
+		n = int(r.uint(1))
+	case t == 0xda || t == 0xc5:
+		n = int(r.uint(2))
+	case t == 0xdb || t == 0xc6:
+		n = int(r.uint(4))
+	case t == 0xc0:
+		return nil
+	default:
+		r.fail(fmt.Errorf("msgpack type 0x%x is not a string", t))
+		return nil
+	}
+
+	return r.next(n)
+}
+
+func (r *msgpReader) readInt() int64 {
+	switch t := r.byte(); {
+	case r.err != nil:
+		return 0
+	case t < 0x80:
+		return int64(t)
+	case t >= 0xe0:
+		return int64(int8(t))
+	case t == 0xcc:
+		return int64(r.uint(1))
+	case t == 0xcd:
+		return int64(r.uint(2))
+	case t == 0xce:
+		return int64(r.uint(4))
+	case t == 0xcf:
+		v := r.uint(8)
+		if v > math.MaxInt64 {
+			r.fail(errMsgpOverflow)
+			return 0
+		}
+		return int64(v)
+	case t == 0xd0:
+		return int64(int8(r.uint(1)))
+	case t == 0xd1:
+		return int64(int16(r.uint(2)))
+	case t == 0xd2:
+		return int64(int32(r.uint(4)))
+	case t == 0xd3:
+		return int64(r.uint(8))
+	case t == 0xca:
+		return r.floatInt(float64(math.Float32frombits(uint32(r.uint(4)))))
+	case t == 0xcb:
+		return r.floatInt(math.Float64frombits(r.uint(8)))
+	case t == 0xc0:
+		return 0
+	default:
+		r.fail(fmt.Errorf("msgpack type 0x%x is not a number", t))
+		return 0
+	}
+}
+
+// floatInt accepts floats holding whole numbers only, cmsgpack writes Lua numbers which are not
+// integers as floats and every number the join script stores is whole.
+func (r *msgpReader) floatInt(f float64) int64 {
+	if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		r.fail(fmt.Errorf("msgpack float %v is not an integer", f))
+		return 0
+	}
+
+	return int64(f)
+}
+
+func (r *msgpReader) readInt32s() []int32 {
+	n := r.readArrayLen()
+	if n == 0 {
+		return nil
+	}
+
+	values := make([]int32, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		values = append(values, int32(r.readInt()))
+	}
+
+	return values
+}
+
+func (r *msgpReader) readBool() bool {
+	switch t := r.byte(); t {
+	case 0xc3:
+		return true
+	case 0xc2, 0xc0:
+		return false
+	default:
+		r.fail(fmt.Errorf("msgpack type 0x%x is not a bool", t))
+		return false
+	}
+}
+
+func (r *msgpReader) readTime() time.Time {
+	if v := r.readInt(); v != 0 {
+		return time.UnixMicro(v)
+	}
+
+	return time.Time{}
+}
+
+// skip reads over a value of any type.
+func (r *msgpReader) skip() {
+	switch t := r.byte(); {
+	case r.err != nil:
+	case t < 0x80 || t >= 0xe0 || t == 0xc0 || t == 0xc2 || t == 0xc3:
+	case t&0xf0 == 0x80:
+		r.skipValues(2 * int(t&0x0f))
+	case t&0xf0 == 0x90:
+		r.skipValues(int(t & 0x0f))
+	case t&0xe0 == 0xa0:
+		r.next(int(t & 0x1f))
+	case t == 0xcc || t == 0xd0:
+		r.next(1)
+	case t == 0xcd || t == 0xd1:
+		r.next(2)
+	case t == 0xce || t == 0xd2 || t == 0xca:
+		r.next(4)
+	case t == 0xcf || t == 0xd3 || t == 0xcb:
+		r.next(8)
+	case t == 0xd9 || t == 0xc4:
+		r.next(int(r.uint(1)))
+	case t == 0xda || t == 0xc5:
+		r.next(int(r.uint(2)))
+	case t == 0xdb || t == 0xc6:
+		r.next(int(r.uint(4)))
+	case t == 0xdc:
+		r.skipValues(int(r.uint(2)))
+	case t == 0xdd:
+		r.skipValues(int(r.uint(4)))
+	case t == 0xde:
+		r.skipValues(2 * int(r.uint(2)))
+	case t == 0xdf:
+		r.skipValues(2 * int(r.uint(4)))
+	default:
+		r.fail(fmt.Errorf("msgpack type 0x%x is not supported", t))
+	}
+}
+
+func (r *msgpReader) skipValues(n int) {
+	for i := 0; i < n && r.err == nil; i++ {
+		r.skip()
+	}
+}
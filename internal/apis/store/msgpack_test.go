@@ -0,0 +1,208 @@
+package store_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/stretchr/testify/require"
+	"github.com/tinylib/msgp/msgp"
+)
+
+// appendLuaNumber packs a Lua number the way cmsgpack in Redis does: whole numbers fitting an int64
+// as the smallest unsigned or signed integer, other numbers as float32 when lossless, else float64.
+func appendLuaNumber(b []byte, n float64) []byte {
+	switch {
+	case n == math.Trunc(n) && n >= 0 && n < math.MaxInt64:
+		return msgp.AppendUint64(b, uint64(n))
+	case n == math.Trunc(n) && n < 0 && n >= math.MinInt64:
+		return msgp.AppendInt64(b, int64(n))
+	case float64(float32(n)) == n:
+		return msgp.AppendFloat32(b, float32(n))
+	default:
+		return msgp.AppendFloat64(b, n)
+	}
+}
+
+// appendLuaLobby packs a lobby table as the join script writes it back with cmsgpack, empty
+// tables become arrays and fields unknown to the codec are kept.
+func appendLuaLobby(id string, rating, category, joinedAt float64, noCategories bool) []byte {
+	b := []byte{0x01}
+	b = msgp.AppendMapHeader(b, 6)
+	b = msgp.AppendString(b, "id")
+	b = msgp.AppendString(b, id)
+	b = msgp.AppendString(b, "categories")
+	if noCategories {
+		b = msgp.AppendArrayHeader(b, 0)
+	} else {
+		b = appendLuaNumber(msgp.AppendArrayHeader(b, 1), category)
+	}
+	b = msgp.AppendString(b, "players")
+	b = msgp.AppendArrayHeader(b, 1)
+	b = msgp.AppendMapHeader(b, 4)
+	b = msgp.AppendString(b, "id")
+	b = msgp.AppendString(b, id)
+	b = msgp.AppendString(b, "rating")
+	b = appendLuaNumber(b, rating)
+	b = msgp.AppendString(b, "categories")
+	b = msgp.AppendArrayHeader(b, 0)
+	b = msgp.AppendString(b, "joined_at")
+	b = appendLuaNumber(b, joinedAt)
+	b = msgp.AppendString(b, "max_players")
+	b = appendLuaNumber(b, 8)
+	b = msgp.AppendString(b, "version")
+	b = appendLuaNumber(b, 3)
+	b = msgp.AppendString(b, "extra")
+	b = msgp.AppendMapHeader(b, 1)
+	b = msgp.AppendString(b, "nested")
+	b = msgp.AppendArrayHeader(b, 2)
+	b = msgp.AppendNil(b)
+	b = msgp.AppendFloat64(b, 0.5)
+
+	return b
+}
+
+func isLuaInt(n float64) bool {
+	return n == math.Trunc(n) && n >= math.MinInt64 && n < math.MaxInt64
+}
+
+func FuzzBinaryCodecReadsCmsgpack(f *testing.F) {
+	f.Add("lobby-1", 1200.0, 3.0, float64(time.Now().UnixMicro()), false)
+	f.Add("", 0.0, 0.0, 0.0, true)
+	f.Add("lobby-2", -40.0, 70000.0, 1.5, false)
+	f.Add("lobby-3", math.MaxUint32+1.0, -1e18, math.Inf(1), false)
+
+	f.Fuzz(func(t *testing.T, id string, rating, category, joinedAt float64, noCategories bool) {
+		var lobby models.Lobby
+		err := store.BinaryCodec{}.Unmarshal(appendLuaLobby(id, rating, category, joinedAt, noCategories), &lobby)
+
+		if !isLuaInt(rating) || !isLuaInt(joinedAt) || (!noCategories && !isLuaInt(category)) {
+			require.ErrorIs(t, err, store.ErrUnknownEncoding)
+			return
+		}
+
+		require.NoError(t, err)
+		require.Equal(t, id, lobby.ID)
+		require.Equal(t, int16(8), lobby.MaxPlayers)
+		require.Equal(t, int16(3), lobby.Version)
+		require.Len(t, lobby.Players, 1)
+		require.Equal(t, id, lobby.Players[0].ID)
+		require.Equal(t, int32(int64(rating)), lobby.Players[0].Rating)
+		require.Nil(t, lobby.Players[0].Categories)
+
+		if noCategories {
+			require.Nil(t, lobby.Categories)
+		} else {
+			require.Equal(t, []int32{int32(int64(category))}, lobby.Categories)
+		}
+
+		if int64(joinedAt) == 0 {
+			require.True(t, lobby.Players[0].JoinedAt.IsZero())
+		} else {
+			require.Equal(t, int64(joinedAt), lobby.Players[0].JoinedAt.UnixMicro())
+		}
+	})
+}
+
+func FuzzBinaryCodecWritesMsgpack(f *testing.F) {
+	f.Add("lobby-1", "party-1", int32(1200), int32(3), time.Now().UnixMicro(), true)
+	f.Add("", "", int32(0), int32(0), int64(0), false)
+	f.Add("lobby-2", "", int32(math.MinInt32), int32(math.MaxInt32), int64(math.MinInt64), false)
+
+	f.Fuzz(func(t *testing.T, id, partyID string, rating, category int32, joinedAt int64, private bool) {
+		lobby := &models.Lobby{
+			ID:         id,
+			Categories: []int32{category},
+			Players: []*models.Player{{
+				ID:       id,
+				Rating:   rating,
+				PartyID:  partyID,
+				JoinedAt: time.UnixMicro(joinedAt),
+			}},
+			MaxPlayers: 8,
+			Private:    private,
+		}
+
+		data, err := store.BinaryCodec{}.Marshal(lobby)
+		require.NoError(t, err)
+		require.Equal(t, byte(0x01), data[0])
+
+		value, rest, err := msgp.ReadIntfBytes(data[1:])
+		require.NoError(t, err)
+		require.Empty(t, rest)
+
+		fields, ok := value.(map[string]any)
+		require.True(t, ok)
+
+		if id != "" {
+			require.Equal(t, id, fields["id"])
+		}
+		require.Equal(t, []any{int64(category)}, intValues(t, fields["categories"]))
+		require.Equal(t, int64(8), intValue(t, fields["max_players"]))
+		require.Equal(t, private, fields["private"] == true)
+
+		players, ok := fields["players"].([]any)
+		require.True(t, ok)
+		require.Len(t, players, 1)
+
+		player, ok := players[0].(map[string]any)
+		require.True(t, ok)
+		require.Equal(t, id, player["id"])
+		require.Equal(t, int64(rating), intValue(t, player["rating"]))
+
+		if partyID != "" {
+			require.Equal(t, partyID, player["party_id"])
+		}
+		if joinedAt != 0 {
+			require.Equal(t, joinedAt, intValue(t, player["joined_at"]))
+		}
+	})
+}
+
+func FuzzBinaryCodecUnmarshal(f *testing.F) {
+	for _, lobby := range []*models.Lobby{{ID: "empty"}, newCodecLobby(2), newCodecLobby(20)} {
+		data, err := store.BinaryCodec{}.Marshal(lobby)
+		require.NoError(f, err)
+		f.Add(data)
+	}
+	f.Add(appendLuaLobby("lobby-1", 1200, 3, 1, false))
+	f.Add([]byte{0x01, 0xdd, 0xff, 0xff, 0xff, 0xff})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var lobby models.Lobby
+		if err := (store.BinaryCodec{}).Unmarshal(data, &lobby); err != nil || data[0] != 0x01 {
+			return
+		}
+
+		// whatever the codec accepts must be a well formed value for a real MessagePack reader
+		_, err := msgp.Skip(data[1:])
+		require.NoError(t, err)
+	})
+}
+
+func intValue(t *testing.T, v any) int64 {
+	switch n := v.(type) {
+	case int64:
+		return n
+	case uint64:
+		require.LessOrEqual(t, n, uint64(math.MaxInt64))
+		return int64(n)
+	default:
+		require.Failf(t, "not an integer", "%T %v", v, v)
+		return 0
+	}
+}
+
+func intValues(t *testing.T, v any) []any {
+	list, ok := v.([]any)
+	require.True(t, ok)
+
+	values := make([]any, len(list))
+	for i, item := range list {
+		values[i] = intValue(t, item)
+	}
+
+	return values
+}
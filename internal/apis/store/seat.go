@@ -0,0 +1,133 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+var ErrSeatNotFound = errors.New("player seat not found")
+
+var removeSeatScript = redis.NewScript(`
+local seat = redis.call("GET", KEYS[1])
+if seat and cjson.decode(seat).lobby_id == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+var reserveSeatScript = redis.NewScript(`
+local seat = {session_id = ""}
+local current = redis.call("GET", KEYS[1])
+if current then
+	seat = cjson.decode(current)
+end
+seat.lobby_id = ARGV[1]
+return redis.call("SET", KEYS[1], cjson.encode(seat), "PX", ARGV[2])
+`)
+
+var detachSeatScript = redis.NewScript(`
+local current = redis.call("GET", KEYS[1])
+if not current then
+	return 0
+end
+local seat = cjson.decode(current)
+if seat.session_id ~= ARGV[1] then
+	return 0
+end
+seat.detached = true
+return redis.call("SET", KEYS[1], cjson.encode(seat), "KEEPTTL")
+`)
+
+var resumeSeatScript = redis.NewScript(`
+local current = redis.call("GET", KEYS[1])
+if not current then
+	return 0
+end
+local seat = cjson.decode(current)
+if seat.lobby_id ~= ARGV[1] or (seat.session_id ~= "" and not seat.detached) then
+	return 0
+end
+seat.session_id = ARGV[2]
+seat.detached = nil
+redis.call("SET", KEYS[1], cjson.encode(seat), "PX", ARGV[3])
+return 1
+`)
+
+// ClaimSeat stores the seat only if the player has none, guarding against concurrent joins.
+func (s *Store) ClaimSeat(ctx context.Context, playerID string, seat *models.Seat, ttl time.Duration) (bool, error) {
+	data, err := json.Marshal(seat)
+	if err != nil {
+		return false, err
+	}
+
+	return s.db.SetNX(ctx, fmt.Sprintf(playerSeatKey, playerID), data, ttl).Result()
+}
+
+func (s *Store) SetSeat(ctx context.Context, playerID string, seat *models.Seat, ttl time.Duration) error {
+	data, err := json.Marshal(seat)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Set(ctx, fmt.Sprintf(playerSeatKey, playerID), data, ttl).Err()
+}
+
+func (s *Store) GetSeat(ctx context.Context, playerID string) (*models.Seat, error) {
+	data, err := s.db.Get(ctx, fmt.Sprintf(playerSeatKey, playerID)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrSeatNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	var seat models.Seat
+	if err = json.Unmarshal(data, &seat); err != nil {
+		return nil, err
+	}
+
+	return &seat, nil
+}
+
+// DetachSeat marks the seat held by sessionID as resumable after its stream dropped.
+func (s *Store) DetachSeat(ctx context.Context, playerID, sessionID string) error {
+	return detachSeatScript.Run(ctx, s.db, []string{fmt.Sprintf(playerSeatKey, playerID)}, sessionID).Err()
+}
+
+// ResumeSeat hands a detached seat in seat.LobbyID over to seat.SessionID, false means the seat
+// is missing, points to another lobby or is still held by a live stream.
+func (s *Store) ResumeSeat(ctx context.Context, playerID string, seat *models.Seat, ttl time.Duration) (bool, error) {
+	resumed, err := resumeSeatScript.Run(ctx, s.db,
+		[]string{fmt.Sprintf(playerSeatKey, playerID)},
+		seat.LobbyID, seat.SessionID, max(ttl.Milliseconds(), 1),
+	).Int()
+
+	return resumed == 1, err
+}
+
+// RemoveSeat deletes the seat only while it still points to lobbyID.
+func (s *Store) RemoveSeat(ctx context.Context, playerID, lobbyID string) error {
+	return removeSeatScript.Run(ctx, s.db, []string{fmt.Sprintf(playerSeatKey, playerID)}, lobbyID).Err()
+}
+
+// reserveSeats points lobby players to the lobby, stream sessions of their seats are kept.
+func reserveSeats(ctx context.Context, pipe redis.Pipeliner, lobby *models.Lobby, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+
+	for _, p := range lobby.Players {
+		reserveSeatScript.Eval(ctx, pipe, []string{fmt.Sprintf(playerSeatKey, p.ID)}, lobby.ID, ttl.Milliseconds())
+	}
+}
+
+func releaseSeats(ctx context.Context, pipe redis.Pipeliner, lobbyID string, players []*models.Player) {
+	for _, p := range players {
+		removeSeatScript.Eval(ctx, pipe, []string{fmt.Sprintf(playerSeatKey, p.ID)}, lobbyID)
+	}
+}
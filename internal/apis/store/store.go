@@ -6,10 +6,12 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/matcher"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
 	"github.com/go-redsync/redsync/v4"
 	redsyncgoredis "github.com/go-redsync/redsync/v4/redis/goredis/v9"
@@ -21,58 +23,166 @@ const (
 	lobbyKey        = "lobby:{%s}"
 	versionLobbyKey = "lobby:version:{%s}"
 	activeLobbyKey  = "lobby:active:{%s}"
-	mutexLobbyKey   = "{lobby:%s}"
+	createdLobbyKey = "lobby:created:{%s}"
+	mutexLobbyKey   = "lobby:mutex:{%s}"
+	legacyMutexKey  = "{lobby:%s}"
+	partyLobbyKey   = "lobby:party:{%s}"
+	waitStatsKey    = "lobby:stats:wait:{%s}"
+	privateLobbyKey = "lobby:private:{%s}"
+	lobbyCodeKey    = "lobby:code:{%s}"
+	lobbyLeaseKey   = "lobby:lease:{%s}"
+	lobbyLeasesKey  = "lobby:leases"
+	lobbyStartKey   = "lobby:start:{%s}"
+	playerSeatKey   = "lobby:seat:{%s}"
 )
 
+const (
+	joinFull           = -1
+	joinLocked         = -2
+	joinStarting       = -3
+	joinLockedAttempts = 5
+	joinLockedDelay    = time.Millisecond * 20
+)
+
+var _ LobbyStore = (*Store)(nil)
+
+var (
+	ErrLobbyNotFound    = errors.New("lobby not found")
+	ErrLobbyFull        = errors.New("lobby is full")
+	ErrPlayerNotInLobby = errors.New("player is not in lobby")
+	ErrLobbiesMismatch  = errors.New("lobbies can not be merged")
+	ErrLobbyCodeTaken   = errors.New("lobby code is already taken")
+	ErrLobbyStarting    = errors.New("lobby is already starting")
+)
+
+// joinLobbyScript appends players to a lobby the same way models.Lobby.AddPlayers does, on lobbies
+// stored by either codec. Lobby data, version, mutex and start claim share a hash slot, so the whole
+// join is one atomic call which backs off while a locked read-modify-write is in progress and refuses
+// lobbies an instance has claimed to start.
+var joinLobbyScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[4]) == 1 then
+	return -3
+end
+
+if redis.call("EXISTS", KEYS[3]) == 1 then
+	return -2
+end
+
+local data = redis.call("GET", KEYS[1])
+if not data then
+	return 0
+end
+
+local binary = string.byte(data, 1) == 1
+local lobby, players, joined_at, in_ready_check
+
+if binary then
+	lobby = cmsgpack.unpack(string.sub(data, 2))
+	players = cmsgpack.unpack(ARGV[1])
+	joined_at = tonumber(ARGV[3])
+	in_ready_check = lobby.ready_check_started_at ~= nil
+else
+	lobby = cjson.decode(data)
+	players = cjson.decode(ARGV[2])
+	joined_at = ARGV[4]
+	-- lobbies written before ready checks have no started_at field and are never in one
+	local started = lobby.ready_check_started_at
+	in_ready_check = started ~= nil and started ~= ARGV[5]
+end
+
+if type(lobby.players) ~= "table" then
+	lobby.players = {}
+end
+
+if in_ready_check or (lobby.max_players or 0) - #lobby.players < #players then
+	return -1
+end
+
+local categories, seen = {}, {}
+local function collect(list)
+	if type(list) ~= "table" then
+		return
+	end
+	for _, c in ipairs(list) do
+		if not seen[c] then
+			seen[c] = true
+			table.insert(categories, c)
+		end
+	end
+end
+
+collect(lobby.categories)
+for _, p in ipairs(players) do
+	table.insert(lobby.players, p)
+	collect(p.categories)
+end
+
+local total = 0
+for _, p in ipairs(lobby.players) do
+	total = total + (p.rating or 0)
+	if not binary and type(p.categories) == "table" and #p.categories == 0 then
+		p.categories = cjson.null
+	end
+end
+
+if #categories > 0 then
+	lobby.categories = categories
+elseif binary then
+	lobby.categories = nil
+else
+	lobby.categories = cjson.null
+end
+
+lobby.avg_rating = math.floor(total / #lobby.players)
+lobby.last_joined_at = joined_at
+lobby.version = (lobby.version or 0) + 1
+
+local encoded
+if binary then
+	encoded = string.char(1) .. cmsgpack.pack(lobby)
+else
+	encoded = cjson.encode(lobby)
+end
+
+redis.call("SET", KEYS[1], encoded, "KEEPTTL")
+redis.call("SET", KEYS[2], lobby.version, "KEEPTTL")
+
+return encoded
+`)
+
+// writeLobbyScript stores lobby data only if its version is newer than the stored one.
+var writeLobbyScript = redis.NewScript(`
+local current = tonumber(redis.call("GET", KEYS[2]) or "0")
+if current >= tonumber(ARGV[2]) then
+	return 0
+end
+
+redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[3])
+redis.call("SET", KEYS[2], ARGV[2], "PX", ARGV[3])
+return 1
+`)
+
 type Store struct {
-	db            redis.UniversalClient
-	redsync       *redsync.Redsync
-	scoreProvider *scorer.ScoreProviders
-	logger        *zap.Logger
+	db      redis.UniversalClient
+	redsync *redsync.Redsync
+	codec   Codec
+	ranking *scorer.Ranking
+	logger  *zap.Logger
 }
 
-func NewStore(db redis.UniversalClient, logger *zap.Logger) *Store {
+func NewStore(db redis.UniversalClient, codec Codec, ranking *scorer.Ranking, logger *zap.Logger) *Store {
 	pool := redsyncgoredis.NewPool(db)
 	return &Store{
-		db:            db,
-		scoreProvider: scorer.NewScoreProviders(),
-		redsync:       redsync.New(pool),
-		logger:        logger,
+		db:      db,
+		codec:   codec,
+		ranking: ranking,
+		redsync: redsync.New(pool),
+		logger:  logger,
 	}
 }
 
 func (s *Store) AddLobby(ctx context.Context, lobby *models.Lobby) error {
-	sp := s.scoreProvider.GetProvider(lobby.Mode)
-	if sp == nil {
-		return fmt.Errorf("no score provider for mode: %s", lobby.Mode)
-	}
-
-	keyLobby := fmt.Sprintf(lobbyKey, lobby.ID)
-	keyScore := fmt.Sprintf(activeLobbyKey, lobby.Mode)
-	keyVersion := fmt.Sprintf(versionLobbyKey, lobby.ID)
-
-	oldVer, _ := s.db.Get(ctx, keyVersion).Int64()
-	if int16(oldVer) >= lobby.Version {
-		return nil
-	}
-
-	score := sp.CalculateScore(lobby)
-
-	data, err := json.Marshal(lobby)
-	if err != nil {
-		s.logger.Error("Failed to marshal lobby", zap.String("lobby_id", lobby.ID), zap.Error(err))
-		return err
-	}
-
-	ttl := time.Until(lobby.ExpireAt)
-	pipe := s.db.TxPipeline()
-
-	pipe.Set(ctx, keyLobby, data, ttl)
-	pipe.ZAdd(ctx, keyScore, redis.Z{Score: score, Member: lobby.ID})
-	pipe.Expire(ctx, keyScore, ttl)
-	pipe.Set(ctx, keyVersion, lobby.Version, ttl)
-
-	if _, err = pipe.Exec(ctx); err != nil {
+	if err := s.writeLobby(ctx, lobby); err != nil {
 		s.logger.Error("Failed to save lobby to db", zap.String("lobby_id", lobby.ID), zap.Error(err))
 		return err
 	}
@@ -81,19 +191,19 @@ func (s *Store) AddLobby(ctx context.Context, lobby *models.Lobby) error {
 }
 
 func (s *Store) GetLobby(ctx context.Context, lobbyID string) (*models.Lobby, error) {
-	key := fmt.Sprintf(lobbyKey, lobbyID)
+	key := lobbyDataKey(lobbyID)
 
 	data, err := s.db.Get(ctx, key).Bytes()
 	if errors.Is(err, redis.Nil) {
 		s.logger.Debug("Lobby not found", zap.String("lobby_id", lobbyID))
-		return nil, err
+		return nil, ErrLobbyNotFound
 	} else if err != nil {
 		s.logger.Error("Failed to get data from db", zap.String("lobby_id", lobbyID), zap.Error(err))
 		return nil, err
 	}
 
 	var lobby models.Lobby
-	if err = json.Unmarshal(data, &lobby); err != nil {
+	if err = s.codec.Unmarshal(data, &lobby); err != nil {
 		s.logger.Error("Failed to unmarshal data", zap.String("lobby_id", lobbyID), zap.Error(err))
 		return nil, err
 	}
@@ -110,7 +220,7 @@ func (s *Store) GetLobbies(ctx context.Context, mode string) ([]*models.Lobby, e
 		return nil, err
 	}
 
-	return s.loadLobbiesByIDs(ctx, mode, ids)
+	return s.loadLobbiesByIDs(ctx, mode, ids, true)
 }
 
 func (s *Store) GetTopLobbies(ctx context.Context, mode string, limit int) ([]*models.Lobby, error) {
@@ -122,7 +232,7 @@ func (s *Store) GetTopLobbies(ctx context.Context, mode string, limit int) ([]*m
 		return nil, err
 	}
 
-	return s.loadLobbiesByIDs(ctx, mode, ids)
+	return s.loadLobbiesByIDs(ctx, mode, ids, true)
 }
 
 func (s *Store) GetLobbiesByScore(ctx context.Context, mode string, min, max float64) ([]*models.Lobby, error) {
@@ -137,118 +247,514 @@ func (s *Store) GetLobbiesByScore(ctx context.Context, mode string, min, max flo
 		return nil, err
 	}
 
-	return s.loadLobbiesByIDs(ctx, mode, ids)
+	return s.loadLobbiesByIDs(ctx, mode, ids, true)
+}
+
+// ListLobbies pages lobbies of the mode oldest first. Pages follow creation time rather than
+// the lobby score, so they stay stable while lobbies fill up.
+func (s *Store) ListLobbies(ctx context.Context, mode string, offset, limit int) ([]*models.Lobby, int64, error) {
+	key := fmt.Sprintf(createdLobbyKey, mode)
+
+	total, err := s.db.ZCard(ctx, key).Result()
+	if err != nil {
+		s.logger.Error("Failed to count lobbies", zap.String("mode", mode), zap.Error(err))
+		return nil, 0, err
+	}
+
+	ids, err := s.db.ZRange(ctx, key, int64(offset), int64(offset+limit-1)).Result()
+	if err != nil {
+		s.logger.Error("Failed to list lobbies", zap.String("mode", mode), zap.Error(err))
+		return nil, 0, err
+	}
+
+	lobbies, err := s.loadLobbiesByIDs(ctx, mode, ids, false, key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return lobbies, total, nil
 }
 
 func (s *Store) AddPlayer(ctx context.Context, lobbyID string, player *models.Player) error {
-	lockKey := fmt.Sprintf(mutexLobbyKey, lobbyID)
+	return s.AddPlayers(ctx, lobbyID, player)
+}
 
-	mutex := s.redsync.NewMutex(lockKey,
-		redsync.WithExpiry(5*time.Second),
-		redsync.WithTries(3),
-		redsync.WithRetryDelayFunc(func(n int) time.Duration {
-			return time.Duration(100+rand.Intn(200)) * time.Millisecond
-		}),
-	)
+func (s *Store) AddPlayers(ctx context.Context, lobbyID string, players ...*models.Player) error {
+	now := time.Now()
+	for _, p := range players {
+		if p.JoinedAt.IsZero() {
+			p.JoinedAt = now
+		}
+	}
 
-	if err := mutex.LockContext(ctx); err != nil {
+	// The stored lobby decides which encoding the script reads, so players are passed in both.
+	jsonPlayers, err := json.Marshal(players)
+	if err != nil {
+		return err
+	}
+
+	args := []any{
+		marshalBinaryPlayers(players),
+		jsonPlayers,
+		now.UnixMicro(),
+		now.Format(time.RFC3339Nano),
+		time.Time{}.Format(time.RFC3339Nano),
+	}
+	keys := []string{
+		lobbyDataKey(lobbyID),
+		fmt.Sprintf(versionLobbyKey, lobbyID),
+		fmt.Sprintf(mutexLobbyKey, lobbyID),
+		fmt.Sprintf(lobbyStartKey, lobbyID),
+	}
+
+	var data string
+
+	for attempt := 0; ; attempt++ {
+		result, err := joinLobbyScript.Run(ctx, s.db, keys, args...).Result()
+		if err != nil {
+			s.logger.Error("Failed to add players to lobby", zap.String("lobby_id", lobbyID), zap.Error(err))
+			return err
+		}
+
+		var ok bool
+		if data, ok = result.(string); ok {
+			break
+		}
+
+		switch code, _ := result.(int64); {
+		case code == joinLocked && attempt < joinLockedAttempts:
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(joinLockedDelay):
+			}
+		case code == joinLocked:
+			return s.addPlayersLocked(ctx, lobbyID, players...)
+		case code == joinFull:
+			return ErrLobbyFull
+		case code == joinStarting:
+			return ErrLobbyStarting
+		default:
+			return ErrLobbyNotFound
+		}
+	}
+
+	var lobby models.Lobby
+	if err = s.codec.Unmarshal([]byte(data), &lobby); err != nil {
 		return err
 	}
 
+	if err = s.IndexLobby(ctx, &lobby); err != nil {
+		s.logger.Warn("Failed to index lobby after join", zap.String("lobby_id", lobbyID), zap.Error(err))
+	}
+
+	return nil
+}
+
+// addPlayersLocked is the read-modify-write join under the lobby mutex,
+// used when the lobby stays locked longer than the script is willing to wait.
+func (s *Store) addPlayersLocked(ctx context.Context, lobbyID string, players ...*models.Player) error {
+	_, err := s.UpdateLobby(ctx, lobbyID, func(lobby *models.Lobby) error {
+		starting, err := s.db.Exists(ctx, fmt.Sprintf(lobbyStartKey, lobbyID)).Result()
+		if err != nil {
+			return err
+		}
+
+		if starting == 1 {
+			return ErrLobbyStarting
+		}
+
+		if ok := lobby.AddPlayers(players...); !ok {
+			return ErrLobbyFull
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+func (s *Store) RemovePlayer(ctx context.Context, lobbyID, playerID string) (*models.Lobby, error) {
+	mutex := s.newLobbyMutex(lobbyID)
+
+	if err := mutex.LockContext(ctx); err != nil {
+		return nil, err
+	}
+
 	defer func() {
 		_, _ = mutex.UnlockContext(ctx)
 	}()
 
 	lobby, err := s.GetLobby(ctx, lobbyID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if ok := lobby.AddPlayer(player); !ok {
-		return errors.New("failed to add player to lobby")
+	if ok := lobby.RemovePlayer(playerID); !ok {
+		return nil, ErrPlayerNotInLobby
 	}
 
 	if err = s.AtomicUpdateLobby(ctx, lobby); err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	if err = s.RemoveSeat(ctx, playerID, lobbyID); err != nil {
+		s.logger.Warn("Failed to release player seat", zap.String("player_id", playerID), zap.Error(err))
+	}
+
+	return lobby, nil
 }
 
-func (s *Store) AtomicUpdateLobby(ctx context.Context, lobby *models.Lobby) error {
-	sp := s.scoreProvider.GetProvider(lobby.Mode)
-	if sp == nil {
-		return fmt.Errorf("no score provider for mode: %s", lobby.Mode)
+func (s *Store) UpdateLobby(ctx context.Context, lobbyID string, update func(lobby *models.Lobby) error) (*models.Lobby, error) {
+	mutex := s.newLobbyMutex(lobbyID)
+
+	if err := mutex.LockContext(ctx); err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_, _ = mutex.UnlockContext(ctx)
+	}()
+
+	lobby, err := s.GetLobby(ctx, lobbyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = update(lobby); err != nil {
+		return nil, err
+	}
+
+	if err = s.AtomicUpdateLobby(ctx, lobby); err != nil {
+		return nil, err
+	}
+
+	return lobby, nil
+}
+
+func (s *Store) MergeLobbies(
+	ctx context.Context,
+	sourceID, targetID string,
+	compatible func(source, target *models.Lobby) bool,
+) (*models.Lobby, *models.Lobby, error) {
+	if sourceID == targetID {
+		return nil, nil, ErrLobbiesMismatch
+	}
+
+	first, second := sourceID, targetID
+	if second < first {
+		first, second = second, first
+	}
+
+	firstMutex := s.newLobbyMutex(first)
+	if err := firstMutex.LockContext(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	defer func() {
+		_, _ = firstMutex.UnlockContext(ctx)
+	}()
+
+	secondMutex := s.newLobbyMutex(second)
+	if err := secondMutex.LockContext(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	defer func() {
+		_, _ = secondMutex.UnlockContext(ctx)
+	}()
+
+	source, err := s.GetLobby(ctx, sourceID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	target, err := s.GetLobby(ctx, targetID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if source.Mode != target.Mode || !compatible(source, target) {
+		return nil, nil, ErrLobbiesMismatch
 	}
 
-	keyVersion := fmt.Sprintf(versionLobbyKey, lobby.ID)
-	oldVer, err := s.db.Get(ctx, keyVersion).Int64()
-	if err != nil && !errors.Is(err, redis.Nil) {
-		s.logger.Error("Failed to get lobby version", zap.String("lobby_id", lobby.ID), zap.Error(err))
+	if ok := target.AddPlayers(source.Players...); !ok {
+		return nil, nil, ErrLobbyFull
+	}
+
+	if err = s.AtomicUpdateLobby(ctx, target); err != nil {
+		return nil, nil, err
+	}
+
+	if err = s.RemoveLobby(ctx, source.ID, source.Mode); err != nil {
+		return nil, nil, err
+	}
+
+	return source, target, nil
+}
+
+func (s *Store) AtomicUpdateLobby(ctx context.Context, lobby *models.Lobby) error {
+	if err := s.writeLobby(ctx, lobby); err != nil {
+		s.logger.Error("Failed to update lobby", zap.String("lobby_id", lobby.ID), zap.Error(err))
 		return err
 	}
 
-	if int16(oldVer) >= lobby.Version {
-		return nil
+	return nil
+}
+
+// writeLobby saves the lobby if its version is newer, then refreshes its score and player seats.
+func (s *Store) writeLobby(ctx context.Context, lobby *models.Lobby) error {
+	if _, ok := matcher.LookupMode(lobby.Mode); !ok {
+		return fmt.Errorf("%w: %s", matcher.ErrUnknownMode, lobby.Mode)
 	}
 
-	data, err := json.Marshal(lobby)
+	data, err := s.codec.Marshal(lobby)
 	if err != nil {
 		return err
 	}
 
-	score := sp.CalculateScore(lobby)
 	ttl := time.Until(lobby.ExpireAt)
 
-	keyLobby := fmt.Sprintf(lobbyKey, lobby.ID)
+	written, err := writeLobbyScript.Run(ctx, s.db,
+		[]string{lobbyDataKey(lobby.ID), fmt.Sprintf(versionLobbyKey, lobby.ID)},
+		data, lobby.Version, max(ttl.Milliseconds(), 1),
+	).Int()
+	if err != nil || written == 0 {
+		return err
+	}
+
+	return s.IndexLobby(ctx, lobby)
+}
+
+// IndexLobby refreshes score, indexes and seats of a stored lobby. They live outside the lobby
+// hash slot, so they are written after the lobby itself and a writer failing in between leaves
+// them stale until the lease holder of the lobby calls IndexLobby again.
+func (s *Store) IndexLobby(ctx context.Context, lobby *models.Lobby) error {
+	ttl := time.Until(lobby.ExpireAt)
 	keyScore := fmt.Sprintf(activeLobbyKey, lobby.Mode)
-	keyVersion = fmt.Sprintf(versionLobbyKey, lobby.ID)
 
-	pipe := s.db.TxPipeline()
+	pipe := s.db.Pipeline()
 
-	pipe.Set(ctx, keyLobby, data, ttl)
-	pipe.ZAdd(ctx, keyScore, redis.Z{Score: score, Member: lobby.ID})
-	pipe.Expire(ctx, keyScore, ttl)
-	pipe.Set(ctx, keyVersion, lobby.Version, ttl)
+	mode, ok := matcher.LookupMode(lobby.Mode)
+	if !ok {
+		return fmt.Errorf("%w: %s", matcher.ErrUnknownMode, lobby.Mode)
+	}
 
-	_, err = pipe.Exec(ctx)
-	if err != nil {
-		s.logger.Error("Failed to update lobby", zap.String("lobby_id", lobby.ID), zap.Error(err))
+	// private lobbies are only listed, matchmaking never sees them
+	var score float64
+	if !lobby.Private {
+		score = s.ranking.Provider(mode.Name, mode.Provider).CalculateScore(lobby)
+		pipe.ZAdd(ctx, keyScore, redis.Z{Score: score, Member: lobby.ID})
+		pipe.Expire(ctx, keyScore, ttl)
+	}
+	pipe.ZAddNX(ctx, fmt.Sprintf(createdLobbyKey, lobby.Mode), redis.Z{Score: float64(lobby.CreatedAt.UnixMilli()), Member: lobby.ID})
+	reserveSeats(ctx, pipe, lobby, ttl)
+
+	if _, err := pipe.Exec(ctx); err != nil || lobby.Private {
 		return err
 	}
 
-	return nil
+	return s.updateIndexes(ctx, lobby, score, ttl)
 }
 
 func (s *Store) RemoveLobby(ctx context.Context, lobbyID, mode string) error {
-	keyLobby := fmt.Sprintf(lobbyKey, lobbyID)
+	keyLobby := lobbyDataKey(lobbyID)
 	keyZSet := fmt.Sprintf(activeLobbyKey, mode)
 	keyVer := fmt.Sprintf(versionLobbyKey, lobbyID)
 
+	var lobby models.Lobby
+	if data, err := s.db.Get(ctx, keyLobby).Bytes(); err == nil {
+		_ = s.codec.Unmarshal(data, &lobby)
+	}
+
 	pipe := s.db.TxPipeline()
 	pipe.Del(ctx, keyLobby)
 	pipe.ZRem(ctx, keyZSet, lobbyID)
+	pipe.ZRem(ctx, fmt.Sprintf(createdLobbyKey, mode), lobbyID)
 	pipe.Del(ctx, keyVer)
+	releaseSeats(ctx, pipe, lobbyID, lobby.Players)
 
 	if _, err := pipe.Exec(ctx); err != nil {
 		s.logger.Error("Failed to remove lobby from db", zap.String("lobby_id", lobbyID), zap.Error(err))
 		return err
 	}
 
+	if err := s.dropIndexes(ctx, lobbyID); err != nil {
+		s.logger.Warn("Failed to remove lobby from indexes", zap.String("lobby_id", lobbyID), zap.Error(err))
+	}
+
 	return nil
 }
 
-func (s *Store) loadLobbiesByIDs(ctx context.Context, mode string, ids []string) ([]*models.Lobby, error) {
-	if len(ids) == 0 {
-		return []*models.Lobby{}, nil
+func (s *Store) RecordWaitTime(ctx context.Context, mode string, wait time.Duration, size int) error {
+	key := fmt.Sprintf(waitStatsKey, mode)
+
+	pipe := s.db.TxPipeline()
+	pipe.LPush(ctx, key, wait.Milliseconds())
+	pipe.LTrim(ctx, key, 0, int64(size-1))
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		s.logger.Error("Failed to record lobby wait time", zap.String("mode", mode), zap.Error(err))
+		return err
 	}
 
-	keyToID := make(map[string]string, len(ids))
-	keys := make([]string, len(ids))
-	for i, id := range ids {
-		key := fmt.Sprintf(lobbyKey, id)
-		keys[i] = key
-		keyToID[key] = id
+	return nil
+}
+
+func (s *Store) GetWaitTimes(ctx context.Context, mode string) ([]time.Duration, error) {
+	values, err := s.db.LRange(ctx, fmt.Sprintf(waitStatsKey, mode), 0, -1).Result()
+	if err != nil {
+		s.logger.Error("Failed to get lobby wait times", zap.String("mode", mode), zap.Error(err))
+		return nil, err
+	}
+
+	waits := make([]time.Duration, 0, len(values))
+	for _, v := range values {
+		ms, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		waits = append(waits, time.Duration(ms)*time.Millisecond)
+	}
+
+	return waits, nil
+}
+
+func (s *Store) GetQueueSize(ctx context.Context, mode string) (lobbies, players int, err error) {
+	ids, err := s.db.ZRange(ctx, fmt.Sprintf(activeLobbyKey, mode), 0, -1).Result()
+	if err != nil {
+		s.logger.Error("Failed to get lobbies", zap.String("mode", mode), zap.Error(err))
+		return 0, 0, err
+	}
+
+	loaded, err := s.loadLobbiesByIDs(ctx, mode, ids, false)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, l := range loaded {
+		if l.InReadyCheck() {
+			continue
+		}
+
+		lobbies++
+		players += len(l.Players)
+	}
+
+	return lobbies, players, nil
+}
+
+func (s *Store) AddPrivateLobby(ctx context.Context, lobby *models.Lobby) error {
+	ok, err := s.db.SetNX(ctx, fmt.Sprintf(lobbyCodeKey, lobby.Code), lobby.ID, time.Until(lobby.ExpireAt)).Result()
+	if err != nil {
+		s.logger.Error("Failed to reserve lobby code", zap.String("lobby_id", lobby.ID), zap.Error(err))
+		return err
+	}
+
+	if !ok {
+		return ErrLobbyCodeTaken
+	}
+
+	if err = s.AddLobby(ctx, lobby); err != nil {
+		if relErr := releaseOwnedScript.Run(ctx, s.db, []string{fmt.Sprintf(lobbyCodeKey, lobby.Code)}, lobby.ID).Err(); relErr != nil {
+			s.logger.Warn("Failed to release lobby code", zap.String("lobby_id", lobby.ID), zap.Error(relErr))
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *Store) GetLobbyByCode(ctx context.Context, code string) (*models.Lobby, error) {
+	lobbyID, err := s.db.Get(ctx, fmt.Sprintf(lobbyCodeKey, code)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrLobbyNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return s.GetLobby(ctx, lobbyID)
+}
+
+func (s *Store) SetPartyLobby(ctx context.Context, partyID, lobbyID string, ttl time.Duration) error {
+	if err := s.db.Set(ctx, fmt.Sprintf(partyLobbyKey, partyID), lobbyID, ttl).Err(); err != nil {
+		s.logger.Error("Failed to save party lobby", zap.String("party_id", partyID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *Store) GetPartyLobby(ctx context.Context, partyID string) (string, error) {
+	lobbyID, err := s.db.Get(ctx, fmt.Sprintf(partyLobbyKey, partyID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", ErrLobbyNotFound
+	} else if err != nil {
+		return "", err
+	}
+
+	return lobbyID, nil
+}
+
+func lobbyDataKey(lobbyID string) string {
+	if models.IsPrivateLobby(lobbyID) {
+		return fmt.Sprintf(privateLobbyKey, lobbyID)
+	}
+
+	return fmt.Sprintf(lobbyKey, lobbyID)
+}
+
+func (s *Store) newLobbyMutex(lobbyID string) *lobbyMutex {
+	return &lobbyMutex{
+		legacy:  s.newMutex(fmt.Sprintf(legacyMutexKey, lobbyID)),
+		current: s.newMutex(fmt.Sprintf(mutexLobbyKey, lobbyID)),
+	}
+}
+
+func (s *Store) newMutex(key string) *redsync.Mutex {
+	return s.redsync.NewMutex(key,
+		redsync.WithExpiry(5*time.Second),
+		redsync.WithTries(3),
+		redsync.WithRetryDelayFunc(func(_ int) time.Duration {
+			return time.Duration(100+rand.Intn(200)) * time.Millisecond
+		}),
+	)
+}
+
+// lobbyMutex holds the lobby lock under the key older instances take and under the key
+// sharing the lobby data hash slot, which the join script checks. Both are taken in the same
+// order by every new instance, so mixed versions still exclude each other during a rollout.
+type lobbyMutex struct {
+	legacy  *redsync.Mutex
+	current *redsync.Mutex
+}
+
+func (m *lobbyMutex) LockContext(ctx context.Context) error {
+	if err := m.legacy.LockContext(ctx); err != nil {
+		return err
+	}
+
+	if err := m.current.LockContext(ctx); err != nil {
+		_, _ = m.legacy.UnlockContext(ctx)
+		return err
+	}
+
+	return nil
+}
+
+func (m *lobbyMutex) UnlockContext(ctx context.Context) (bool, error) {
+	ok, err := m.current.UnlockContext(ctx)
+	legacyOK, legacyErr := m.legacy.UnlockContext(ctx)
+
+	return ok && legacyOK, errors.Join(err, legacyErr)
+}
+
+// groupKeysBySlot splits keys by hash slot so every MGet stays on one node.
+// Standalone and sentinel clients serve all keys from a single master.
+func (s *Store) groupKeysBySlot(ctx context.Context, keys []string) (map[int64][]string, error) {
+	if _, ok := s.db.(*redis.ClusterClient); !ok {
+		return map[int64][]string{0: keys}, nil
 	}
 
 	slotMap := make(map[int64][]string)
@@ -261,6 +767,28 @@ func (s *Store) loadLobbiesByIDs(ctx context.Context, mode string, ids []string)
 		slotMap[slot] = append(slotMap[slot], key)
 	}
 
+	return slotMap, nil
+}
+
+// loadLobbiesByIDs reads lobbies and drops ids of missing ones from the active set and given index sets.
+func (s *Store) loadLobbiesByIDs(ctx context.Context, mode string, ids []string, joinableOnly bool, indexKeys ...string) ([]*models.Lobby, error) {
+	if len(ids) == 0 {
+		return []*models.Lobby{}, nil
+	}
+
+	keyToID := make(map[string]string, len(ids))
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		key := lobbyDataKey(id)
+		keys[i] = key
+		keyToID[key] = id
+	}
+
+	slotMap, err := s.groupKeysBySlot(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
 	type redisData struct {
 		Key string
 		Val any
@@ -311,10 +839,9 @@ func (s *Store) loadLobbiesByIDs(ctx context.Context, mode string, ids []string)
 	var missingKeys []interface{}
 
 	for pairs := range resultCh {
-		for i, p := range pairs {
+		for _, p := range pairs {
 			if p.Val == nil {
-				// s.logger.Warn("Skipping missing lobby", zap.String("id", ids[i]))
-				missingKeys = append(missingKeys, fmt.Sprintf(lobbyKey, ids[i]))
+				missingKeys = append(missingKeys, keyToID[p.Key])
 				continue
 			}
 
@@ -324,23 +851,29 @@ func (s *Store) loadLobbiesByIDs(ctx context.Context, mode string, ids []string)
 				continue
 			}
 			var lobby models.Lobby
-			if err := json.Unmarshal([]byte(strVal), &lobby); err != nil {
+			if err := s.codec.Unmarshal([]byte(strVal), &lobby); err != nil {
 				s.logger.Error("Failed to unmarshal lobby", zap.String("key", p.Key), zap.Error(err))
 				continue
 			}
 
-			if lobby.CanAddPlayer() {
+			if !joinableOnly || lobby.CanAddPlayer() {
 				lobbies = append(lobbies, &lobby)
 			}
 		}
 	}
 
 	defer func() {
-		activeLobbiesKey := fmt.Sprintf(activeLobbyKey, mode)
-		if len(missingKeys) > 0 {
-			if _, err := s.db.ZRem(ctx, activeLobbiesKey, missingKeys...).Result(); err != nil {
-				s.logger.Warn("Failed to clean up broken lobby references", zap.Error(err))
-			}
+		if len(missingKeys) == 0 {
+			return
+		}
+
+		pipe := s.db.Pipeline()
+		for _, key := range append([]string{fmt.Sprintf(activeLobbyKey, mode)}, indexKeys...) {
+			pipe.ZRem(ctx, key, missingKeys...)
+		}
+
+		if _, err := pipe.Exec(ctx); err != nil {
+			s.logger.Warn("Failed to clean up broken lobby references", zap.Error(err))
 		}
 	}()
 
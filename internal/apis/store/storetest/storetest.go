@@ -0,0 +1,641 @@
+package storetest
+
+import (
+	"context"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMode = "mega"
+	testTTL  = time.Minute
+)
+
+// Factory returns an empty store, every test case gets a new one.
+type Factory func(t *testing.T) store.LobbyStore
+
+func Run(t *testing.T, newStore Factory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, s store.LobbyStore)
+	}{
+		{"AddAndGetLobby", testAddAndGetLobby},
+		{"GetMissingLobby", testGetMissingLobby},
+		{"AddLobbyIgnoresSameVersion", testAddLobbyIgnoresSameVersion},
+		{"AtomicUpdateLobbyVersioning", testAtomicUpdateLobbyVersioning},
+		{"AddPlayer", testAddPlayer},
+		{"AddPlayerToFullLobby", testAddPlayerToFullLobby},
+		{"ConcurrentAddPlayer", testConcurrentAddPlayer},
+		{"RemovePlayer", testRemovePlayer},
+		{"GetTopLobbies", testGetTopLobbies},
+		{"GetLobbiesByScore", testGetLobbiesByScore},
+		{"FindCandidateLobbies", testFindCandidateLobbies},
+		{"ListLobbies", testListLobbies},
+		{"RemoveLobby", testRemoveLobby},
+		{"MergeLobbies", testMergeLobbies},
+		{"PrivateLobby", testPrivateLobby},
+		{"PartyLobby", testPartyLobby},
+		{"WaitTimes", testWaitTimes},
+		{"Seats", testSeats},
+		{"SeatReservation", testSeatReservation},
+		{"SeatResume", testSeatResume},
+		{"Leases", testLeases},
+		{"LobbyStartClaim", testLobbyStartClaim},
+		{"AddPlayerToStartingLobby", testAddPlayerToStartingLobby},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.fn(t, newStore(t))
+		})
+	}
+}
+
+func testAddAndGetLobby(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+	lobby := newLobby(3, 8)
+
+	require.NoError(t, s.AddLobby(ctx, lobby))
+
+	got, err := s.GetLobby(ctx, lobby.ID)
+	require.NoError(t, err)
+	require.Equal(t, lobby.ID, got.ID)
+	require.Equal(t, lobby.Mode, got.Mode)
+	require.Equal(t, lobby.Version, got.Version)
+	require.Len(t, got.Players, 3)
+}
+
+func testGetMissingLobby(t *testing.T, s store.LobbyStore) {
+	_, err := s.GetLobby(context.Background(), uuid.NewString())
+	require.ErrorIs(t, err, store.ErrLobbyNotFound)
+}
+
+func testAddLobbyIgnoresSameVersion(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+	lobby := newLobby(1, 8)
+
+	require.NoError(t, s.AddLobby(ctx, lobby))
+
+	lobby.Players = append(lobby.Players, newPlayer())
+	require.NoError(t, s.AddLobby(ctx, lobby))
+
+	got, err := s.GetLobby(ctx, lobby.ID)
+	require.NoError(t, err)
+	require.Len(t, got.Players, 1)
+}
+
+func testAtomicUpdateLobbyVersioning(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+	lobby := newLobby(1, 8)
+
+	require.NoError(t, s.AddLobby(ctx, lobby))
+
+	updated := *lobby
+	require.True(t, updated.AddPlayer(newPlayer()))
+	require.NoError(t, s.AtomicUpdateLobby(ctx, &updated))
+
+	stale := *lobby
+	stale.Players = nil
+	require.NoError(t, s.AtomicUpdateLobby(ctx, &stale))
+
+	got, err := s.GetLobby(ctx, lobby.ID)
+	require.NoError(t, err)
+	require.Equal(t, updated.Version, got.Version)
+	require.Len(t, got.Players, 2)
+}
+
+func testAddPlayer(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+	lobby := newLobby(1, 8)
+
+	require.NoError(t, s.AddLobby(ctx, lobby))
+
+	player := newPlayer()
+	require.NoError(t, s.AddPlayer(ctx, lobby.ID, player))
+
+	got, err := s.GetLobby(ctx, lobby.ID)
+	require.NoError(t, err)
+	require.True(t, got.HasPlayer(player.ID))
+	require.Equal(t, lobby.Version+1, got.Version)
+
+	require.ErrorIs(t, s.AddPlayer(ctx, uuid.NewString(), newPlayer()), store.ErrLobbyNotFound)
+}
+
+func testAddPlayerToFullLobby(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+	lobby := newLobby(2, 2)
+
+	require.NoError(t, s.AddLobby(ctx, lobby))
+	require.ErrorIs(t, s.AddPlayer(ctx, lobby.ID, newPlayer()), store.ErrLobbyFull)
+}
+
+func testConcurrentAddPlayer(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+	lobby := newLobby(0, 4)
+
+	require.NoError(t, s.AddLobby(ctx, lobby))
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		added int
+	)
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := s.AddPlayer(ctx, lobby.ID, newPlayer()); err == nil {
+				mu.Lock()
+				added++
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	got, err := s.GetLobby(ctx, lobby.ID)
+	require.NoError(t, err)
+	require.LessOrEqual(t, added, 4)
+	require.Len(t, got.Players, added)
+}
+
+func testRemovePlayer(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+	lobby := newLobby(2, 8)
+
+	require.NoError(t, s.AddLobby(ctx, lobby))
+
+	got, err := s.RemovePlayer(ctx, lobby.ID, lobby.Players[0].ID)
+	require.NoError(t, err)
+	require.Len(t, got.Players, 1)
+
+	// the store releases the seat of a removed player, callers do not
+	_, err = s.GetSeat(ctx, lobby.Players[0].ID)
+	require.ErrorIs(t, err, store.ErrSeatNotFound)
+
+	seat, err := s.GetSeat(ctx, lobby.Players[1].ID)
+	require.NoError(t, err)
+	require.Equal(t, lobby.ID, seat.LobbyID)
+
+	_, err = s.RemovePlayer(ctx, lobby.ID, uuid.NewString())
+	require.ErrorIs(t, err, store.ErrPlayerNotInLobby)
+}
+
+func testGetTopLobbies(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+
+	small := newLobby(1, 8)
+	large := newLobby(5, 8)
+	full := newLobby(8, 8)
+
+	for _, l := range []*models.Lobby{small, large, full} {
+		require.NoError(t, s.AddLobby(ctx, l))
+	}
+
+	top, err := s.GetTopLobbies(ctx, testMode, 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{large.ID, small.ID}, lobbyIDs(top))
+
+	top, err = s.GetTopLobbies(ctx, testMode, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{large.ID}, lobbyIDs(top))
+}
+
+func testGetLobbiesByScore(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+
+	small := newLobby(1, 8)
+	large := newLobby(5, 8)
+
+	for _, l := range []*models.Lobby{small, large} {
+		require.NoError(t, s.AddLobby(ctx, l))
+	}
+
+	lobbies, err := s.GetLobbiesByScore(ctx, testMode, 0, 2.0/8.0)
+	require.NoError(t, err)
+	require.Equal(t, []string{small.ID}, lobbyIDs(lobbies))
+}
+
+func testFindCandidateLobbies(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+
+	sameCategory := newLobby(0, 8)
+	sameCategory.AddPlayers(
+		&models.Player{ID: uuid.NewString(), Rating: 3000, Categories: []int32{7}},
+		&models.Player{ID: uuid.NewString(), Rating: 3000, Categories: []int32{9}},
+		&models.Player{ID: uuid.NewString(), Rating: 3000, Categories: []int32{9}},
+	)
+
+	closeRating := newLobby(1, 8)
+	unrelated := newLobby(0, 8)
+	unrelated.AddPlayers(&models.Player{ID: uuid.NewString(), Rating: 5000, Categories: []int32{9}})
+
+	for _, l := range []*models.Lobby{sameCategory, closeRating, unrelated} {
+		require.NoError(t, s.AddLobby(ctx, l))
+	}
+
+	query := store.CandidateQuery{Categories: []int32{7}, MinRating: 850, MaxRating: 1350}
+
+	candidates, err := s.FindCandidateLobbies(ctx, testMode, query, 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{sameCategory.ID, closeRating.ID}, lobbyIDs(candidates))
+
+	candidates, err = s.FindCandidateLobbies(ctx, testMode, query, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{sameCategory.ID}, lobbyIDs(candidates))
+
+	candidates, err = s.FindCandidateLobbies(ctx, testMode, store.CandidateQuery{MaxRating: math.MaxInt32}, 10)
+	require.NoError(t, err)
+	require.Len(t, candidates, 3)
+
+	_, err = s.RemovePlayer(ctx, sameCategory.ID, sameCategory.Players[0].ID)
+	require.NoError(t, err)
+	require.NoError(t, s.RemoveLobby(ctx, closeRating.ID, closeRating.Mode))
+
+	candidates, err = s.FindCandidateLobbies(ctx, testMode, query, 10)
+	require.NoError(t, err)
+	require.Empty(t, candidates)
+}
+
+func testListLobbies(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+
+	lobbies := []*models.Lobby{newLobby(1, 8), newLobby(1, 8), newLobby(1, 8)}
+	for i, l := range lobbies {
+		l.CreatedAt = time.Now().Add(-time.Duration(len(lobbies)-i) * time.Minute)
+		require.NoError(t, s.AddLobby(ctx, l))
+	}
+
+	// Filling up the newest lobby raises its score but must not reorder pages.
+	require.NoError(t, s.AddPlayers(ctx, lobbies[2].ID, newPlayer(), newPlayer()))
+
+	page, total, err := s.ListLobbies(ctx, testMode, 0, 2)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, total)
+	require.Equal(t, []string{lobbies[0].ID, lobbies[1].ID}, lobbyIDs(page))
+
+	page, _, err = s.ListLobbies(ctx, testMode, 2, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{lobbies[2].ID}, lobbyIDs(page))
+
+	require.NoError(t, s.RemoveLobby(ctx, lobbies[0].ID, lobbies[0].Mode))
+
+	page, total, err = s.ListLobbies(ctx, testMode, 0, 2)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, total)
+	require.Equal(t, []string{lobbies[1].ID, lobbies[2].ID}, lobbyIDs(page))
+}
+
+func testRemoveLobby(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+	lobby := newLobby(1, 8)
+
+	require.NoError(t, s.AddLobby(ctx, lobby))
+	require.NoError(t, s.RemoveLobby(ctx, lobby.ID, lobby.Mode))
+
+	_, err := s.GetLobby(ctx, lobby.ID)
+	require.ErrorIs(t, err, store.ErrLobbyNotFound)
+
+	top, err := s.GetTopLobbies(ctx, testMode, 10)
+	require.NoError(t, err)
+	require.Empty(t, top)
+}
+
+func testMergeLobbies(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+
+	source := newLobby(2, 8)
+	target := newLobby(3, 8)
+
+	for _, l := range []*models.Lobby{source, target} {
+		require.NoError(t, s.AddLobby(ctx, l))
+	}
+
+	_, _, err := s.MergeLobbies(ctx, source.ID, target.ID, func(_, _ *models.Lobby) bool { return false })
+	require.ErrorIs(t, err, store.ErrLobbiesMismatch)
+
+	_, merged, err := s.MergeLobbies(ctx, source.ID, target.ID, func(_, _ *models.Lobby) bool { return true })
+	require.NoError(t, err)
+	require.Len(t, merged.Players, 5)
+
+	_, err = s.GetLobby(ctx, source.ID)
+	require.ErrorIs(t, err, store.ErrLobbyNotFound)
+
+	got, err := s.GetLobby(ctx, target.ID)
+	require.NoError(t, err)
+	require.Len(t, got.Players, 5)
+}
+
+func testPrivateLobby(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+	code := uuid.NewString()[:6]
+
+	lobby := models.NewPrivateLobby(uuid.NewString(), code, testMode, newPlayer(), testTTL)
+	lobby.MaxPlayers = 4
+
+	require.NoError(t, s.AddPrivateLobby(ctx, lobby))
+
+	got, err := s.GetLobbyByCode(ctx, code)
+	require.NoError(t, err)
+	require.Equal(t, lobby.ID, got.ID)
+
+	top, err := s.GetTopLobbies(ctx, testMode, 10)
+	require.NoError(t, err)
+	require.Empty(t, top)
+
+	page, total, err := s.ListLobbies(ctx, testMode, 0, 10)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, total)
+	require.Equal(t, []string{lobby.ID}, lobbyIDs(page))
+
+	other := models.NewPrivateLobby(uuid.NewString(), code, testMode, newPlayer(), testTTL)
+	require.ErrorIs(t, s.AddPrivateLobby(ctx, other), store.ErrLobbyCodeTaken)
+
+	_, err = s.GetLobbyByCode(ctx, uuid.NewString()[:6])
+	require.ErrorIs(t, err, store.ErrLobbyNotFound)
+}
+
+func testPartyLobby(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+	partyID := uuid.NewString()
+	lobbyID := uuid.NewString()
+
+	require.NoError(t, s.SetPartyLobby(ctx, partyID, lobbyID, testTTL))
+
+	got, err := s.GetPartyLobby(ctx, partyID)
+	require.NoError(t, err)
+	require.Equal(t, lobbyID, got)
+
+	_, err = s.GetPartyLobby(ctx, uuid.NewString())
+	require.ErrorIs(t, err, store.ErrLobbyNotFound)
+}
+
+func testWaitTimes(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+
+	for i := 1; i <= 5; i++ {
+		require.NoError(t, s.RecordWaitTime(ctx, testMode, time.Duration(i)*time.Second, 3))
+	}
+
+	waits, err := s.GetWaitTimes(ctx, testMode)
+	require.NoError(t, err)
+	require.Equal(t, []time.Duration{5 * time.Second, 4 * time.Second, 3 * time.Second}, waits)
+}
+
+func testSeats(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+	playerID := uuid.NewString()
+	lobbyID := uuid.NewString()
+
+	_, err := s.GetSeat(ctx, playerID)
+	require.ErrorIs(t, err, store.ErrSeatNotFound)
+
+	claimed, err := s.ClaimSeat(ctx, playerID, &models.Seat{SessionID: "first"}, testTTL)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	claimed, err = s.ClaimSeat(ctx, playerID, &models.Seat{SessionID: "second"}, testTTL)
+	require.NoError(t, err)
+	require.False(t, claimed)
+
+	require.NoError(t, s.SetSeat(ctx, playerID, &models.Seat{LobbyID: lobbyID, SessionID: "first"}, testTTL))
+
+	seat, err := s.GetSeat(ctx, playerID)
+	require.NoError(t, err)
+	require.Equal(t, models.Seat{LobbyID: lobbyID, SessionID: "first"}, *seat)
+
+	require.NoError(t, s.RemoveSeat(ctx, playerID, uuid.NewString()))
+
+	_, err = s.GetSeat(ctx, playerID)
+	require.NoError(t, err)
+
+	require.NoError(t, s.RemoveSeat(ctx, playerID, lobbyID))
+
+	_, err = s.GetSeat(ctx, playerID)
+	require.ErrorIs(t, err, store.ErrSeatNotFound)
+}
+
+func testSeatResume(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+	playerID := uuid.NewString()
+	lobbyID := uuid.NewString()
+
+	require.NoError(t, s.SetSeat(ctx, playerID, &models.Seat{LobbyID: lobbyID, SessionID: "first"}, testTTL))
+
+	resumed, err := s.ResumeSeat(ctx, playerID, &models.Seat{LobbyID: lobbyID, SessionID: "second"}, testTTL)
+	require.NoError(t, err)
+	require.False(t, resumed)
+
+	require.NoError(t, s.DetachSeat(ctx, playerID, "other"))
+
+	seat, err := s.GetSeat(ctx, playerID)
+	require.NoError(t, err)
+	require.False(t, seat.Detached)
+
+	require.NoError(t, s.DetachSeat(ctx, playerID, "first"))
+
+	resumed, err = s.ResumeSeat(ctx, playerID, &models.Seat{LobbyID: uuid.NewString(), SessionID: "second"}, testTTL)
+	require.NoError(t, err)
+	require.False(t, resumed)
+
+	resumed, err = s.ResumeSeat(ctx, playerID, &models.Seat{LobbyID: lobbyID, SessionID: "second"}, testTTL)
+	require.NoError(t, err)
+	require.True(t, resumed)
+
+	resumed, err = s.ResumeSeat(ctx, playerID, &models.Seat{LobbyID: lobbyID, SessionID: "third"}, testTTL)
+	require.NoError(t, err)
+	require.False(t, resumed)
+
+	seat, err = s.GetSeat(ctx, playerID)
+	require.NoError(t, err)
+	require.Equal(t, models.Seat{LobbyID: lobbyID, SessionID: "second"}, *seat)
+}
+
+func testSeatReservation(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+	lobby := newLobby(3, 8)
+	first, second, third := lobby.Players[0].ID, lobby.Players[1].ID, lobby.Players[2].ID
+
+	claimed, err := s.ClaimSeat(ctx, first, &models.Seat{SessionID: "session"}, testTTL)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	require.NoError(t, s.AddLobby(ctx, lobby))
+
+	seat, err := s.GetSeat(ctx, first)
+	require.NoError(t, err)
+	require.Equal(t, models.Seat{LobbyID: lobby.ID, SessionID: "session"}, *seat)
+
+	seat, err = s.GetSeat(ctx, second)
+	require.NoError(t, err)
+	require.Equal(t, lobby.ID, seat.LobbyID)
+
+	_, err = s.RemovePlayer(ctx, lobby.ID, second)
+	require.NoError(t, err)
+
+	_, err = s.GetSeat(ctx, second)
+	require.ErrorIs(t, err, store.ErrSeatNotFound)
+
+	player := newPlayer()
+	require.NoError(t, s.AddPlayer(ctx, lobby.ID, player))
+
+	seat, err = s.GetSeat(ctx, player.ID)
+	require.NoError(t, err)
+	require.Equal(t, lobby.ID, seat.LobbyID)
+
+	require.NoError(t, s.RemoveLobby(ctx, lobby.ID, lobby.Mode))
+
+	for _, id := range []string{first, third, player.ID} {
+		_, err = s.GetSeat(ctx, id)
+		require.ErrorIs(t, err, store.ErrSeatNotFound)
+	}
+}
+
+func testLeases(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+	lobbyID := uuid.NewString()
+
+	acquired, err := s.AcquireLease(ctx, lobbyID, "first", 200*time.Millisecond)
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	acquired, err = s.AcquireLease(ctx, lobbyID, "second", testTTL)
+	require.NoError(t, err)
+	require.False(t, acquired)
+
+	renewed, err := s.RenewLease(ctx, lobbyID, "second", testTTL)
+	require.NoError(t, err)
+	require.False(t, renewed)
+
+	require.NoError(t, s.ReleaseLease(ctx, lobbyID, "second"))
+
+	expired, err := s.GetExpiredLeases(ctx, 10)
+	require.NoError(t, err)
+	require.NotContains(t, expired, lobbyID)
+
+	time.Sleep(300 * time.Millisecond)
+
+	expired, err = s.GetExpiredLeases(ctx, 10)
+	require.NoError(t, err)
+	require.Contains(t, expired, lobbyID)
+
+	acquired, err = s.AcquireLease(ctx, lobbyID, "second", testTTL)
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	renewed, err = s.RenewLease(ctx, lobbyID, "second", testTTL)
+	require.NoError(t, err)
+	require.True(t, renewed)
+
+	require.NoError(t, s.HandoffLease(ctx, lobbyID, "second"))
+
+	expired, err = s.GetExpiredLeases(ctx, 10)
+	require.NoError(t, err)
+	require.Contains(t, expired, lobbyID)
+
+	acquired, err = s.AcquireLease(ctx, lobbyID, "third", testTTL)
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	require.NoError(t, s.ReleaseLease(ctx, lobbyID, "third"))
+
+	expired, err = s.GetExpiredLeases(ctx, 10)
+	require.NoError(t, err)
+	require.NotContains(t, expired, lobbyID)
+
+	require.NoError(t, s.OrphanLease(ctx, lobbyID))
+
+	expired, err = s.GetExpiredLeases(ctx, 10)
+	require.NoError(t, err)
+	require.Contains(t, expired, lobbyID)
+
+	acquired, err = s.AcquireLease(ctx, lobbyID, "fourth", testTTL)
+	require.NoError(t, err)
+	require.True(t, acquired)
+}
+
+func testLobbyStartClaim(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+	lobbyID := uuid.NewString()
+
+	claimed, err := s.ClaimLobbyStart(ctx, lobbyID, "first", testTTL)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	claimed, err = s.ClaimLobbyStart(ctx, lobbyID, "second", testTTL)
+	require.NoError(t, err)
+	require.False(t, claimed)
+
+	require.NoError(t, s.ReleaseLobbyStart(ctx, lobbyID, "second"))
+
+	claimed, err = s.ClaimLobbyStart(ctx, lobbyID, "second", testTTL)
+	require.NoError(t, err)
+	require.False(t, claimed)
+
+	require.NoError(t, s.ReleaseLobbyStart(ctx, lobbyID, "first"))
+
+	claimed, err = s.ClaimLobbyStart(ctx, lobbyID, "second", testTTL)
+	require.NoError(t, err)
+	require.True(t, claimed)
+}
+
+func testAddPlayerToStartingLobby(t *testing.T, s store.LobbyStore) {
+	ctx := context.Background()
+	lobby := newLobby(1, 4)
+
+	require.NoError(t, s.AddLobby(ctx, lobby))
+
+	claimed, err := s.ClaimLobbyStart(ctx, lobby.ID, "owner", testTTL)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	require.ErrorIs(t, s.AddPlayer(ctx, lobby.ID, newPlayer()), store.ErrLobbyStarting)
+
+	stored, err := s.GetLobby(ctx, lobby.ID)
+	require.NoError(t, err)
+	require.Len(t, stored.Players, 1)
+
+	require.NoError(t, s.ReleaseLobbyStart(ctx, lobby.ID, "owner"))
+	require.NoError(t, s.AddPlayer(ctx, lobby.ID, newPlayer()))
+}
+
+func newLobby(players, maxPlayers int) *models.Lobby {
+	list := make([]*models.Player, 0, players)
+	for i := 0; i < players; i++ {
+		list = append(list, newPlayer())
+	}
+
+	lobby := models.NewLobby(uuid.NewString(), testMode, list, testTTL)
+	lobby.MinPlayers = 1
+	lobby.MaxPlayers = int16(maxPlayers)
+
+	return lobby
+}
+
+func newPlayer() *models.Player {
+	return &models.Player{
+		ID:         uuid.NewString(),
+		Rating:     1000,
+		Categories: []int32{1, 2},
+		JoinedAt:   time.Now(),
+	}
+}
+
+func lobbyIDs(lobbies []*models.Lobby) []string {
+	ids := make([]string, 0, len(lobbies))
+	for _, l := range lobbies {
+		ids = append(ids, l.ID)
+	}
+
+	return ids
+}
@@ -27,37 +27,35 @@ type JoinLobbyResponse struct {
 	Reason   string `json:"reason,omitempty"`
 }
 
+// streamSender serialises the sends of one stream, nothing is sent after its last status.
+type streamSender struct {
+	mu     sync.Mutex
+	closed bool
+}
+
 type StreamManager struct {
 	ns            *nats.Conn
 	mu            sync.RWMutex
-	localStreams  map[string]map[string]grpc.ServerStreamingServer[lobbyv1.LobbyStatus]
 	remoteStreams map[string]map[string]grpc.ServerStreamingServer[lobbyv1.LobbyStatus]
-	store         *store.Store
+	subscriptions map[string]map[string]*nats.Subscription
+	senders       map[grpc.ServerStreamingServer[lobbyv1.LobbyStatus]]*streamSender
+	redirects     map[string]chan string
+	store         store.LobbyStore
 	logger        *zap.Logger
 }
 
-func NewStreamManager(ns *nats.Conn, store *store.Store, logger *zap.Logger) *StreamManager {
+func NewStreamManager(ns *nats.Conn, store store.LobbyStore, logger *zap.Logger) *StreamManager {
 	return &StreamManager{
 		ns:            ns,
-		localStreams:  make(map[string]map[string]grpc.ServerStreamingServer[lobbyv1.LobbyStatus]),
 		remoteStreams: make(map[string]map[string]grpc.ServerStreamingServer[lobbyv1.LobbyStatus]),
+		subscriptions: make(map[string]map[string]*nats.Subscription),
+		senders:       make(map[grpc.ServerStreamingServer[lobbyv1.LobbyStatus]]*streamSender),
+		redirects:     make(map[string]chan string),
 		store:         store,
 		logger:        logger,
 	}
 }
 
-func (s *StreamManager) RegisterStream(lobbyID, playerID string, stream grpc.ServerStreamingServer[lobbyv1.LobbyStatus]) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if s.localStreams[lobbyID] == nil {
-		s.localStreams[lobbyID] = make(map[string]grpc.ServerStreamingServer[lobbyv1.LobbyStatus])
-	}
-
-	s.localStreams[lobbyID][playerID] = stream
-
-	go s.watchStream(lobbyID, playerID, stream)
-}
-
 func (s *StreamManager) RegisterStreamWithSubscription(
 	ctx context.Context,
 	lobbyID, playerID string,
@@ -79,26 +77,152 @@ func (s *StreamManager) RegisterStreamWithSubscription(
 			return
 		}
 
-		if err := stream.Send(&status); err != nil {
-			s.logger.Warn("Failed to send lobby status over stream", zap.String("player_id", playerID), zap.Error(err))
+		if status.PlayerId != "" && status.PlayerId != playerID {
 			return
 		}
+
+		if status.PlayerId == playerID && status.LobbyId != lobbyID {
+			s.moveStream(lobbyID, playerID, &status, stream)
+			return
+		}
+
+		if err := s.Send(stream, &status); err != nil {
+			s.logger.Warn("Failed to send lobby status over stream", zap.String("player_id", playerID), zap.Error(err))
+		}
+
+		if status.PlayerId == playerID && removesPlayer(&status) {
+			s.unregisterOwnStream(lobbyID, playerID, stream)
+			s.notifyRedirect(playerID, "")
+		}
 	})
 	if err != nil {
 		s.logger.Error("Failed to subscribe to NATS channel", zap.String("subject", subject), zap.Error(err))
 		return
 	}
 
+	s.mu.Lock()
+	if s.remoteStreams[lobbyID][playerID] != stream {
+		// A newer stream of the player registered meanwhile.
+		s.mu.Unlock()
+		_ = subscription.Unsubscribe()
+		return
+	}
+	s.replaceSubscription(lobbyID, playerID, subscription)
+	s.mu.Unlock()
+
 	go func() {
 		<-ctx.Done()
-		_ = subscription.Unsubscribe()
+		s.unregisterOwnStream(lobbyID, playerID, stream)
+	}()
+}
+
+// Send writes status to stream. gRPC does not allow concurrent sends on one stream while
+// subscription callbacks of a moved or resumed stream may overlap, so every send of a stream
+// goes through its sender. Statuses after the last one are dropped.
+func (s *StreamManager) Send(stream grpc.ServerStreamingServer[lobbyv1.LobbyStatus], status *lobbyv1.LobbyStatus) error {
+	sender := s.sender(stream)
+
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	if sender.closed {
+		return nil
+	}
+
+	return stream.Send(status)
+}
+
+// SendLast unsubscribes the player stream from lobby updates and sends its final status,
+// a callback which is still running can not send anything after it.
+func (s *StreamManager) SendLast(
+	lobbyID, playerID string,
+	stream grpc.ServerStreamingServer[lobbyv1.LobbyStatus],
+	status *lobbyv1.LobbyStatus,
+) error {
+	s.unregisterOwnStream(lobbyID, playerID, stream)
+
+	sender := s.sender(stream)
+
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	if sender.closed {
+		return nil
+	}
+	sender.closed = true
+
+	return stream.Send(status)
+}
+
+// sender returns the sender of stream, which is dropped once the stream ends.
+func (s *StreamManager) sender(stream grpc.ServerStreamingServer[lobbyv1.LobbyStatus]) *streamSender {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sender, ok := s.senders[stream]
+	if ok {
+		return sender
+	}
+
+	sender = &streamSender{}
+	s.senders[stream] = sender
+
+	go func() {
+		<-stream.Context().Done()
+
 		s.mu.Lock()
-		delete(s.remoteStreams[lobbyID], playerID)
-		if len(s.remoteStreams[lobbyID]) == 0 {
-			delete(s.remoteStreams, lobbyID)
-		}
+		delete(s.senders, stream)
 		s.mu.Unlock()
 	}()
+
+	return sender
+}
+
+func (s *StreamManager) UnregisterStream(lobbyID, playerID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.removeStream(lobbyID, playerID)
+}
+
+// unregisterOwnStream removes the player stream only while stream is still the registered one,
+// a stream resumed by a newer connection keeps its subscription.
+func (s *StreamManager) unregisterOwnStream(lobbyID, playerID string, stream grpc.ServerStreamingServer[lobbyv1.LobbyStatus]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if current, ok := s.remoteStreams[lobbyID][playerID]; ok && current != stream {
+		return
+	}
+
+	s.removeStream(lobbyID, playerID)
+}
+
+// replaceSubscription stores the player subscription and drops the one it replaces, s.mu must be held.
+func (s *StreamManager) replaceSubscription(lobbyID, playerID string, subscription *nats.Subscription) {
+	if previous, ok := s.subscriptions[lobbyID][playerID]; ok {
+		_ = previous.Unsubscribe()
+	}
+
+	if s.subscriptions[lobbyID] == nil {
+		s.subscriptions[lobbyID] = make(map[string]*nats.Subscription)
+	}
+	s.subscriptions[lobbyID][playerID] = subscription
+}
+
+func (s *StreamManager) removeStream(lobbyID, playerID string) {
+	if subscription, ok := s.subscriptions[lobbyID][playerID]; ok {
+		_ = subscription.Unsubscribe()
+		delete(s.subscriptions[lobbyID], playerID)
+		if len(s.subscriptions[lobbyID]) == 0 {
+			delete(s.subscriptions, lobbyID)
+		}
+	}
+
+	delete(s.remoteStreams[lobbyID], playerID)
+	if len(s.remoteStreams[lobbyID]) == 0 {
+		delete(s.remoteStreams, lobbyID)
+	}
 }
 
 func (s *StreamManager) PublishLobbyStatus(lobbyID string, status *lobbyv1.LobbyStatus) error {
@@ -117,24 +241,83 @@ func (s *StreamManager) PublishLobbyStatus(lobbyID string, status *lobbyv1.Lobby
 	return nil
 }
 
-func (s *StreamManager) BroadcastLobbyUpdate(lobbyID string, status *lobbyv1.LobbyStatus) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+func (s *StreamManager) RedirectStream(fromLobbyID, playerID string, status *lobbyv1.LobbyStatus) {
+	status.PlayerId = playerID
 
-	for id, stream := range s.localStreams[lobbyID] {
-		if err := stream.Send(status); err != nil {
-			delete(s.localStreams[lobbyID], id)
-		}
+	if err := s.PublishLobbyStatus(fromLobbyID, status); err != nil {
+		s.logger.Warn("Failed to publish lobby redirect",
+			zap.String("lobby_id", fromLobbyID),
+			zap.String("player_id", playerID),
+			zap.Error(err))
 	}
 }
 
-func (s *StreamManager) watchStream(lobbyID, playerID string, stream grpc.ServerStreamingServer[lobbyv1.LobbyStatus]) {
-	ctx := stream.Context()
+// WatchRedirects returns the lobby ids the player stream is moved to,
+// an empty id means the player was removed from its lobby.
+func (s *StreamManager) WatchRedirects(playerID string) <-chan string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ch := make(chan string, 1)
+	s.redirects[playerID] = ch
 
-	<-ctx.Done()
+	return ch
+}
+
+// UnwatchRedirects stops redirects to ch, a channel of a newer stream of the player is kept.
+func (s *StreamManager) UnwatchRedirects(playerID string, ch <-chan string) {
 	s.mu.Lock()
-	if s.localStreams[lobbyID] != nil && s.localStreams[lobbyID][playerID] != nil {
-		delete(s.localStreams[lobbyID], playerID)
+	defer s.mu.Unlock()
+
+	if current, ok := s.redirects[playerID]; ok && current == ch {
+		delete(s.redirects, playerID)
 	}
-	s.mu.Unlock()
+}
+
+func (s *StreamManager) moveStream(
+	fromLobbyID, playerID string,
+	status *lobbyv1.LobbyStatus,
+	stream grpc.ServerStreamingServer[lobbyv1.LobbyStatus],
+) {
+	s.unregisterOwnStream(fromLobbyID, playerID, stream)
+
+	if err := s.Send(stream, status); err != nil {
+		s.logger.Warn("Failed to send lobby redirect over stream", zap.String("player_id", playerID), zap.Error(err))
+		return
+	}
+
+	s.RegisterStreamWithSubscription(stream.Context(), status.LobbyId, playerID, stream)
+
+	s.notifyRedirect(playerID, status.LobbyId)
+
+	s.logger.Debug("Stream redirected",
+		zap.String("from_lobby_id", fromLobbyID),
+		zap.String("to_lobby_id", status.LobbyId),
+		zap.String("player_id", playerID))
+}
+
+func (s *StreamManager) notifyRedirect(playerID, lobbyID string) {
+	s.mu.RLock()
+	ch, ok := s.redirects[playerID]
+	s.mu.RUnlock()
+
+	if !ok {
+		return
+	}
+
+	select {
+	case <-ch:
+	default:
+	}
+
+	select {
+	case ch <- lobbyID:
+	default:
+	}
+}
+
+// removesPlayer reports whether a status addressed to one player ends their seat in the lobby,
+// as kicks, declined ready checks and moves to a newer join request do.
+func removesPlayer(status *lobbyv1.LobbyStatus) bool {
+	return status.Status == lobbyv1.Status_STATUS_ERROR || status.Status == lobbyv1.Status_STATUS_TIMEOUT
 }
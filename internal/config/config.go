@@ -1,25 +1,57 @@
 package config
 
 import (
+	"time"
+
 	"github.com/QuizWars-Ecosystem/go-common/pkg/config"
 	"github.com/QuizWars-Ecosystem/go-common/pkg/log"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/allocator"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/handler"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/lobby"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/stats"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/matcher"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
 )
 
 type Config struct {
 	*config.ServiceConfig `mapstructure:"service"`
-	Logger                *log.Config     `mapstructure:"logger"`
-	Redis                 *RedisConfig    `mapstructure:"redis"`
-	NATS                  *NATSConfig     `mapstructure:"nats"`
-	Lobby                 *lobby.Config   `mapstructure:"lobby"`
-	Handler               *handler.Config `mapstructure:"handler"`
-	Matcher               *matcher.Config `mapstructure:"matcher"`
+	Logger                *log.Config       `mapstructure:"logger"`
+	Redis                 *RedisConfig      `mapstructure:"redis"`
+	NATS                  *NATSConfig       `mapstructure:"nats"`
+	Lobby                 *lobby.Config     `mapstructure:"lobby"`
+	Handler               *handler.Config   `mapstructure:"handler"`
+	Matcher               *matcher.Config   `mapstructure:"matcher"`
+	Ranking               *scorer.Config    `mapstructure:"ranking"`
+	Allocator             *allocator.Config `mapstructure:"allocator"`
+	Stats                 *stats.Config     `mapstructure:"stats"`
+	Store                 string            `mapstructure:"store" default:"redis"`
+	DrainDelay            time.Duration     `mapstructure:"drain_delay" default:"5s"`
+	// AdminPort serves the lobby admin service apart from the public gRPC port and is not
+	// registered in consul, it must only be reachable from the operator network.
+	AdminPort int `mapstructure:"admin_port" default:"50052"`
 }
 
+// Lobby store backends. StoreRedis shares lobbies between instances, StoreMemory keeps them
+// in process and suits single instance and local runs only.
+const (
+	StoreRedis  = "redis"
+	StoreMemory = "memory"
+)
+
+const (
+	RedisModeCluster    = "cluster"
+	RedisModeStandalone = "standalone"
+	RedisModeSentinel   = "sentinel"
+)
+
+// RedisConfig.Codec picks the encoding lobbies are written in, reads accept both. Keep "json" until
+// every instance runs a version reading binary lobbies and switch to "binary" in a later deploy,
+// older instances fail on every binary value.
 type RedisConfig struct {
-	URLs []string `mapstructure:"urls"`
+	Mode       string   `mapstructure:"mode" default:"cluster"`
+	URLs       []string `mapstructure:"urls"`
+	MasterName string   `mapstructure:"master_name"`
+	Codec      string   `mapstructure:"codec" default:"json"`
 }
 
 type NATSConfig struct {
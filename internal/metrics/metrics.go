@@ -12,6 +12,36 @@ var (
 		Buckets: []float64{5, 10, 30, 60, 120, 300},
 	}, []string{"mode"})
 
+	LobbyRatingSpread = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "lobby_rating_spread",
+		Help:    "Difference between highest and lowest player rating of started lobby",
+		Buckets: []float64{0, 50, 100, 200, 300, 500, 750, 1000, 1500, 2000},
+	}, []string{"mode"})
+
+	LobbyRatingStdDev = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "lobby_rating_stddev",
+		Help:    "Standard deviation of player ratings of started lobby",
+		Buckets: []float64{0, 25, 50, 100, 150, 250, 400, 600, 1000},
+	}, []string{"mode"})
+
+	LobbyCategoryOverlap = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "lobby_category_overlap",
+		Help:    "Mean Jaccard index of player categories of started lobby",
+		Buckets: prometheus.LinearBuckets(0, 0.1, 11),
+	}, []string{"mode"})
+
+	LobbyFillRatio = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "lobby_fill_ratio",
+		Help:    "Players over max players of started lobby",
+		Buckets: prometheus.LinearBuckets(0.1, 0.1, 10),
+	}, []string{"mode"})
+
+	PlayerWaitTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "player_wait_seconds",
+		Help:    "Time from joining a queue to lobby start per player",
+		Buckets: []float64{1, 5, 10, 30, 60, 120, 300, 600},
+	}, []string{"mode"})
+
 	LobbyPlayersCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "lobby_players_current",
 		Help: "Current number of players in lobby",
@@ -32,6 +62,21 @@ var (
 		Help: "Players waiting in queue per mode",
 	}, []string{"mode"})
 
+	MatcherConfigVersion = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "matcher_config_version",
+		Help: "Version of applied matcher config",
+	})
+
+	MatcherConfigReloads = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "matcher_config_reloads_total",
+		Help: "Total matcher config reloads",
+	}, []string{"result"}) // applied, rejected
+
+	ModeScoringConfig = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mode_scoring_config_info",
+		Help: "Scoring config currently used per mode",
+	}, []string{"mode", "config", "version"})
+
 	ActiveGRPCStreams = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "grpc_streams_active",
 		Help: "Current active gRPC streams",
@@ -45,10 +90,18 @@ var (
 
 func Initialize() {
 	prometheus.MustRegister(LobbyWaitTime)
+	prometheus.MustRegister(LobbyRatingSpread)
+	prometheus.MustRegister(LobbyRatingStdDev)
+	prometheus.MustRegister(LobbyCategoryOverlap)
+	prometheus.MustRegister(LobbyFillRatio)
+	prometheus.MustRegister(PlayerWaitTime)
 	prometheus.MustRegister(LobbyPlayersCount)
 	prometheus.MustRegister(LobbyStatusChanges)
 	prometheus.MustRegister(ModeLobbiesCount)
 	prometheus.MustRegister(ModePlayersQueued)
+	prometheus.MustRegister(MatcherConfigVersion)
+	prometheus.MustRegister(MatcherConfigReloads)
+	prometheus.MustRegister(ModeScoringConfig)
 	prometheus.MustRegister(ActiveGRPCStreams)
 	prometheus.MustRegister(GRPCStreamErrors)
 }
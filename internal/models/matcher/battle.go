@@ -1,18 +1,31 @@
 package matcher
 
-import "github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+import (
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
+)
 
 var _ Scorer = (*BattleScorer)(nil)
 
+func init() {
+	MustRegisterMode(Mode{
+		Name:       "battle",
+		Scorer:     func(cfg ScoringConfig) Scorer { return &BattleScorer{cfg} },
+		Provider:   &scorer.BattleScoreProvider{},
+		MinPlayers: 2,
+		MaxPlayers: 4,
+	})
+}
+
 type BattleScorer struct {
 	Config ScoringConfig
 }
 
-func (s *BattleScorer) Filter(_ *models.Lobby, _ *models.Player) bool {
-	return true
+func (s *BattleScorer) Filter(lobby *models.Lobby, player *models.Player) bool {
+	return withinRatingWindow(s.Config, lobby, player)
 }
 
 func (s *BattleScorer) Score(lobby *models.Lobby, player *models.Player) float64 {
-	return s.Config.RatingWeight*ratingScore(player.Rating, lobby.AvgRating, s.Config.MaxRatingDiff) +
+	return s.Config.RatingWeight*ratingScore(player.Rating, lobby.AvgRating, ratingWindow(s.Config, lobby, player)) +
 		s.Config.CategoryWeight*categoryScore(player.Categories, lobby.Categories)
 }
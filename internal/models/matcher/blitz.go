@@ -5,23 +5,34 @@ import (
 	"time"
 
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
 )
 
 var _ Scorer = (*BlitzScorer)(nil)
 
+func init() {
+	MustRegisterMode(Mode{
+		Name:       "blitz",
+		Scorer:     func(cfg ScoringConfig) Scorer { return &BlitzScorer{cfg} },
+		Provider:   &scorer.BlitzScoreProvider{},
+		MinPlayers: 3,
+		MaxPlayers: 6,
+	})
+}
+
 type BlitzScorer struct {
 	Config ScoringConfig
 }
 
 func (s *BlitzScorer) Filter(lobby *models.Lobby, player *models.Player) bool {
 	matchRatio := categoryScore(player.Categories, lobby.Categories)
-	return matchRatio >= s.Config.MinCategoryMatch
+	return matchRatio >= s.Config.MinCategoryMatch && withinRatingWindow(s.Config, lobby, player)
 }
 
 func (s *BlitzScorer) Score(lobby *models.Lobby, player *models.Player) float64 {
 	waitTimeScore := 1 - math.Min(1, time.Since(lobby.CreatedAt).Minutes()/10)
 
-	return s.Config.RatingWeight*ratingScore(player.Rating, lobby.AvgRating, s.Config.MaxRatingDiff) +
+	return s.Config.RatingWeight*ratingScore(player.Rating, lobby.AvgRating, ratingWindow(s.Config, lobby, player)) +
 		s.Config.CategoryWeight*categoryScore(player.Categories, lobby.Categories) +
 		s.Config.FillWeight*(fillScore(len(lobby.Players), int(lobby.MaxPlayers))*0.7+waitTimeScore*0.3)
 }
@@ -1,20 +1,33 @@
 package matcher
 
-import "github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+import (
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
+)
 
 var _ Scorer = (*ClassicScorer)(nil)
 
+func init() {
+	MustRegisterMode(Mode{
+		Name:       "classic",
+		Scorer:     func(cfg ScoringConfig) Scorer { return &ClassicScorer{cfg} },
+		Provider:   &scorer.ClassicScoreProvider{},
+		MinPlayers: 4,
+		MaxPlayers: 10,
+	})
+}
+
 type ClassicScorer struct {
 	Config ScoringConfig
 }
 
 func (s *ClassicScorer) Filter(lobby *models.Lobby, player *models.Player) bool {
 	matchRatio := categoryScore(player.Categories, lobby.Categories)
-	return matchRatio >= s.Config.MinCategoryMatch
+	return matchRatio >= s.Config.MinCategoryMatch && withinRatingWindow(s.Config, lobby, player)
 }
 
 func (s *ClassicScorer) Score(lobby *models.Lobby, player *models.Player) float64 {
-	return s.Config.RatingWeight*ratingScore(player.Rating, lobby.AvgRating, s.Config.MaxRatingDiff) +
+	return s.Config.RatingWeight*ratingScore(player.Rating, lobby.AvgRating, ratingWindow(s.Config, lobby, player)) +
 		s.Config.CategoryWeight*categoryScore(player.Categories, lobby.Categories) +
 		s.Config.FillWeight*fillScore(len(lobby.Players), int(lobby.MaxPlayers))
 }
@@ -1,29 +1,126 @@
 package matcher
 
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// BuiltinConfigKey names the scoring config used by modes when neither their own nor the default one is set.
+const BuiltinConfigKey = "builtin"
+
+var builtinScoringConfig = ScoringConfig{
+	CategoryWeight:   0.5,
+	RatingWeight:     0.3,
+	FillWeight:       0.2,
+	MaxRatingDiff:    1000.0,
+	MinCategoryMatch: 0.3,
+}
+
 type ScoringConfig struct {
 	RatingWeight     float64 `mapstructure:"rating_weight" yaml:"rating_weight"`                       // from 0 to 1
 	CategoryWeight   float64 `mapstructure:"category_weight" yaml:"category_weight"`                   // from 0 to 1
 	FillWeight       float64 `mapstructure:"fill_weight" yaml:"fill_weight"`                           // from 0 to 1
 	MaxRatingDiff    float64 `mapstructure:"max_rating_diff" yaml:"max_rating_diff"`                   // e.g 1000
 	MinCategoryMatch float64 `mapstructure:"min_category_match_ratio" yaml:"min_category_match_ratio"` // from 0 to 1
+
+	InitialRatingWindow float64 `mapstructure:"initial_rating_window" yaml:"initial_rating_window"` // e.g 200, 0 disables window expansion
+	RatingWindowGrowth  float64 `mapstructure:"rating_window_growth" yaml:"rating_window_growth"`   // rating points per second of waiting, e.g 10
+	MaxRatingWindow     float64 `mapstructure:"max_rating_window" yaml:"max_rating_window"`         // e.g 1500, 0 means unlimited
+}
+
+func (c ScoringConfig) RatingWindow(wait time.Duration) float64 {
+	if c.InitialRatingWindow <= 0 {
+		return c.MaxRatingDiff
+	}
+
+	window := c.InitialRatingWindow + c.RatingWindowGrowth*wait.Seconds()
+	if c.MaxRatingWindow > 0 && window > c.MaxRatingWindow {
+		return c.MaxRatingWindow
+	}
+
+	return window
+}
+
+func (c ScoringConfig) Validate() error {
+	var errs []error
+
+	for _, v := range []struct {
+		name  string
+		value float64
+		max   float64
+	}{
+		{"rating_weight", c.RatingWeight, 1},
+		{"category_weight", c.CategoryWeight, 1},
+		{"fill_weight", c.FillWeight, 1},
+		{"min_category_match_ratio", c.MinCategoryMatch, 1},
+		{"max_rating_diff", c.MaxRatingDiff, math.MaxFloat64},
+		{"initial_rating_window", c.InitialRatingWindow, math.MaxFloat64},
+		{"rating_window_growth", c.RatingWindowGrowth, math.MaxFloat64},
+		{"max_rating_window", c.MaxRatingWindow, math.MaxFloat64},
+	} {
+		if math.IsNaN(v.value) || v.value < 0 || v.value > v.max {
+			errs = append(errs, fmt.Errorf("%s must be from 0 to %v, got %v", v.name, v.max, v.value))
+		}
+	}
+
+	if c.MaxRatingDiff == 0 {
+		errs = append(errs, errors.New("max_rating_diff must be positive"))
+	}
+
+	if c.MaxRatingWindow > 0 && c.MaxRatingWindow < c.InitialRatingWindow {
+		errs = append(errs, fmt.Errorf("max_rating_window %v is less than initial_rating_window %v", c.MaxRatingWindow, c.InitialRatingWindow))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c ScoringConfig) expandsRatingWindow() bool {
+	return c.InitialRatingWindow > 0
 }
 
 type Config struct {
-	Configs map[string]ScoringConfig `mapstructure:"configs" yaml:"configs"`
+	Configs map[string]ScoringConfig  `mapstructure:"configs" yaml:"configs"`
+	Modes   map[string]ModeDefinition `mapstructure:"modes" yaml:"modes"`
 }
 
-func (c *Config) GetConfig(mode string) ScoringConfig {
-	cfg, ok := c.Configs[mode]
-	if !ok {
-		cfg = ScoringConfig{
-			CategoryWeight:   0.5,
-			RatingWeight:     0.3,
-			FillWeight:       0.2,
-			MaxRatingDiff:    1000.0,
-			MinCategoryMatch: 0.3,
+// Validate checks every scoring config, mode names are checked by ValidateModes.
+func (c *Config) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	var errs []error
+
+	for name, cfg := range c.Configs {
+		if err := cfg.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("scoring config %q: %w", name, err))
 		}
-		c.Configs[mode] = cfg
 	}
 
+	return errors.Join(errs...)
+}
+
+func (c *Config) GetConfig(mode string) ScoringConfig {
+	_, cfg := c.Resolve(mode)
 	return cfg
 }
+
+// Resolve returns the scoring config of the mode together with the key it was taken from,
+// modes without their own config use the default one and then the built-in one.
+func (c *Config) Resolve(mode string) (string, ScoringConfig) {
+	if c == nil {
+		return BuiltinConfigKey, builtinScoringConfig
+	}
+
+	if cfg, ok := c.Configs[mode]; ok {
+		return mode, cfg
+	}
+
+	if cfg, ok := c.Configs[DefaultConfigKey]; ok {
+		return DefaultConfigKey, cfg
+	}
+
+	return BuiltinConfigKey, builtinScoringConfig
+}
@@ -10,11 +10,11 @@ type DefaultScorer struct {
 
 func (s *DefaultScorer) Filter(lobby *models.Lobby, player *models.Player) bool {
 	matchRatio := categoryScore(player.Categories, lobby.Categories)
-	return matchRatio >= s.Config.MinCategoryMatch
+	return matchRatio >= s.Config.MinCategoryMatch && withinRatingWindow(s.Config, lobby, player)
 }
 
 func (s *DefaultScorer) Score(lobby *models.Lobby, player *models.Player) float64 {
-	return s.Config.RatingWeight*ratingScore(player.Rating, lobby.AvgRating, s.Config.MaxRatingDiff) +
+	return s.Config.RatingWeight*ratingScore(player.Rating, lobby.AvgRating, ratingWindow(s.Config, lobby, player)) +
 		s.Config.CategoryWeight*categoryScore(player.Categories, lobby.Categories) +
 		s.Config.FillWeight*fillScore(len(lobby.Players), int(lobby.MaxPlayers))
 }
@@ -1,18 +1,31 @@
 package matcher
 
-import "github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+import (
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
+)
 
 var _ Scorer = (*DuelScorer)(nil)
 
+func init() {
+	MustRegisterMode(Mode{
+		Name:       "duel",
+		Scorer:     func(cfg ScoringConfig) Scorer { return &DuelScorer{cfg} },
+		Provider:   &scorer.DuelScoreProvider{},
+		MinPlayers: 2,
+		MaxPlayers: 2,
+	})
+}
+
 type DuelScorer struct {
 	Config ScoringConfig
 }
 
-func (s *DuelScorer) Filter(_ *models.Lobby, _ *models.Player) bool {
-	return true
+func (s *DuelScorer) Filter(lobby *models.Lobby, player *models.Player) bool {
+	return withinRatingWindow(s.Config, lobby, player)
 }
 
 func (s *DuelScorer) Score(lobby *models.Lobby, player *models.Player) float64 {
-	return s.Config.RatingWeight*ratingScore(player.Rating, lobby.AvgRating, s.Config.MaxRatingDiff) +
+	return s.Config.RatingWeight*ratingScore(player.Rating, lobby.AvgRating, ratingWindow(s.Config, lobby, player)) +
 		s.Config.CategoryWeight*categoryScore(player.Categories, lobby.Categories)
 }
@@ -1,6 +1,11 @@
 package matcher
 
-import "math"
+import (
+	"math"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+)
 
 func ratingScore(playerRating, avgRating int32, maxDiff float64) float64 {
 	diff := math.Abs(float64(playerRating - avgRating))
@@ -11,6 +16,33 @@ func ratingScore(playerRating, avgRating int32, maxDiff float64) float64 {
 	return score
 }
 
+func ratingWindow(cfg ScoringConfig, lobby *models.Lobby, player *models.Player) float64 {
+	return cfg.RatingWindow(waitTime(lobby, player))
+}
+
+func withinRatingWindow(cfg ScoringConfig, lobby *models.Lobby, player *models.Player) bool {
+	if !cfg.expandsRatingWindow() || len(lobby.Players) == 0 {
+		return true
+	}
+
+	diff := math.Abs(float64(player.Rating - lobby.AvgRating))
+	return diff <= ratingWindow(cfg, lobby, player)
+}
+
+func waitTime(lobby *models.Lobby, player *models.Player) time.Duration {
+	var wait time.Duration
+
+	if !player.JoinedAt.IsZero() {
+		wait = time.Since(player.JoinedAt)
+	}
+
+	if oldest := lobby.OldestJoinedAt(); !oldest.IsZero() {
+		wait = max(wait, time.Since(oldest))
+	}
+
+	return wait
+}
+
 func categoryScore(playerCategories, lobbyCategories []int32) float64 {
 	return jaccardIndex(playerCategories, lobbyCategories)
 }
@@ -1,15 +1,28 @@
 package matcher
 
-import "github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+import (
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
+)
 
 var _ Scorer = (*MegaScorer)(nil)
 
+func init() {
+	MustRegisterMode(Mode{
+		Name:       "mega",
+		Scorer:     func(cfg ScoringConfig) Scorer { return &MegaScorer{cfg} },
+		Provider:   &scorer.MegaScoreProvider{},
+		MinPlayers: 24,
+		MaxPlayers: 128,
+	})
+}
+
 type MegaScorer struct {
 	Config ScoringConfig
 }
 
-func (s *MegaScorer) Filter(_ *models.Lobby, _ *models.Player) bool {
-	return true
+func (s *MegaScorer) Filter(lobby *models.Lobby, player *models.Player) bool {
+	return withinRatingWindow(s.Config, lobby, player)
 }
 
 func (s *MegaScorer) Score(lobby *models.Lobby, _ *models.Player) float64 {
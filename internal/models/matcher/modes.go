@@ -0,0 +1,186 @@
+package matcher
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
+)
+
+// DefaultConfigKey is the scoring config used by modes without their own one.
+const DefaultConfigKey = "default"
+
+var (
+	ErrUnknownMode  = errors.New("unknown mode")
+	ErrModesChanged = errors.New("modes can not change on reload, restart to apply them")
+)
+
+// ScorerFactory builds the filter and player to lobby scorer of a mode from its scoring config.
+type ScorerFactory func(cfg ScoringConfig) Scorer
+
+// Mode bundles everything matchmaking needs to know about a game mode.
+type Mode struct {
+	Name       string
+	Scorer     ScorerFactory
+	Provider   scorer.Provider
+	MinPlayers int16
+	MaxPlayers int16
+	// Teams is the number of teams lobbies of the mode are split into, zero keeps them flat.
+	Teams int16
+
+	configured bool
+}
+
+// ModeDefinition adds a mode from configuration, reusing filter, scorer and ranking of a registered base mode.
+// Teams defaults to the team layout of the base mode.
+type ModeDefinition struct {
+	Base       string `mapstructure:"base" yaml:"base"`
+	MinPlayers int16  `mapstructure:"min_players" yaml:"min_players"`
+	MaxPlayers int16  `mapstructure:"max_players" yaml:"max_players"`
+	Teams      int16  `mapstructure:"teams" yaml:"teams"`
+}
+
+var registry = struct {
+	mu    sync.RWMutex
+	modes map[string]Mode
+}{modes: make(map[string]Mode)}
+
+// RegisterMode adds a mode to the registry, a mode with the same name must not exist yet.
+func RegisterMode(mode Mode) error {
+	if err := mode.validate(); err != nil {
+		return err
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	if _, ok := registry.modes[mode.Name]; ok {
+		return fmt.Errorf("mode %q is already registered", mode.Name)
+	}
+
+	registry.modes[mode.Name] = mode
+
+	return nil
+}
+
+func MustRegisterMode(mode Mode) {
+	if err := RegisterMode(mode); err != nil {
+		panic(err)
+	}
+}
+
+// DefineModes replaces modes added from configuration, built-in modes can not be redefined.
+// The registry is built once at startup, config reloads changing definitions are rejected.
+func DefineModes(definitions map[string]ModeDefinition) error {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	modes := make(map[string]Mode, len(registry.modes)+len(definitions))
+	for name, mode := range registry.modes {
+		if !mode.configured {
+			modes[name] = mode
+		}
+	}
+
+	for name, def := range definitions {
+		if _, ok := modes[name]; ok {
+			return fmt.Errorf("mode %q is built-in and can not be redefined", name)
+		}
+
+		base, ok := modes[def.Base]
+		if !ok {
+			return fmt.Errorf("base of mode %q: %w: %q", name, ErrUnknownMode, def.Base)
+		}
+
+		mode := Mode{
+			Name:       name,
+			Scorer:     base.Scorer,
+			Provider:   base.Provider,
+			MinPlayers: def.MinPlayers,
+			MaxPlayers: def.MaxPlayers,
+			Teams:      base.Teams,
+			configured: true,
+		}
+
+		if def.Teams != 0 {
+			mode.Teams = def.Teams
+		}
+
+		if err := mode.validate(); err != nil {
+			return err
+		}
+
+		modes[name] = mode
+	}
+
+	registry.modes = modes
+
+	return nil
+}
+
+func LookupMode(name string) (Mode, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	mode, ok := registry.modes[name]
+	return mode, ok
+}
+
+func HasMode(name string) bool {
+	_, ok := LookupMode(name)
+	return ok
+}
+
+func ModeNames() []string {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	names := make([]string, 0, len(registry.modes))
+	for name := range registry.modes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// ValidateModes fails on scoring configs and mode references which do not match a registered mode.
+func ValidateModes(cfg *Config, references ...string) error {
+	var errs []error
+
+	for name := range cfg.Configs {
+		if _, ok := LookupMode(name); !ok && name != DefaultConfigKey {
+			errs = append(errs, fmt.Errorf("scoring config: %w: %q", ErrUnknownMode, name))
+		}
+	}
+
+	seen := make([]string, 0, len(references))
+	for _, name := range references {
+		if _, ok := LookupMode(name); !ok && !slices.Contains(seen, name) {
+			errs = append(errs, fmt.Errorf("%w: %q", ErrUnknownMode, name))
+			seen = append(seen, name)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (m Mode) validate() error {
+	switch {
+	case m.Name == "":
+		return errors.New("mode name is empty")
+	case m.Scorer == nil:
+		return fmt.Errorf("mode %q has no scorer", m.Name)
+	case m.Provider == nil:
+		return fmt.Errorf("mode %q has no ranking provider", m.Name)
+	case m.MinPlayers < 1 || m.MaxPlayers < m.MinPlayers:
+		return fmt.Errorf("mode %q has invalid player bounds %d..%d", m.Name, m.MinPlayers, m.MaxPlayers)
+	case m.Teams < 0 || m.Teams == 1 || m.Teams > m.MaxPlayers:
+		return fmt.Errorf("mode %q has invalid team count %d", m.Name, m.Teams)
+	}
+
+	return nil
+}
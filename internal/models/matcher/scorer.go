@@ -1,7 +1,12 @@
 package matcher
 
 import (
+	"errors"
+	"maps"
+	"slices"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
@@ -14,84 +19,117 @@ type Scorer interface {
 
 var _ abstractions.ConfigSubscriber[*Config] = (*LobbyScorer)(nil)
 
+// ModeScoring is the scoring config a mode currently uses and the config key it comes from.
+type ModeScoring struct {
+	Mode   string
+	Source string
+	Config ScoringConfig
+}
+
+// ScoringSnapshot describes an applied matcher config, version grows with every applied update.
+type ScoringSnapshot struct {
+	Version   uint64
+	AppliedAt time.Time
+	Modes     []ModeScoring
+}
+
+// scoringState is never modified after it is stored, updates replace it as a whole.
+type scoringState struct {
+	config   *Config
+	scorers  map[string]Scorer
+	snapshot ScoringSnapshot
+}
+
 type LobbyScorer struct {
-	scorers map[string]Scorer
-	mx      sync.RWMutex
-	config  *Config
+	state atomic.Pointer[scoringState]
+	mx    sync.Mutex
 }
 
-func NewLobbyScorer(config *Config) *LobbyScorer {
-	scorers := make(map[string]Scorer)
+func NewLobbyScorer(config *Config) (*LobbyScorer, error) {
+	s := &LobbyScorer{}
 
-	for mode, cfg := range config.Configs {
-		scorers[mode] = newScorer(mode, cfg)
+	if err := s.UpdateConfig(config); err != nil {
+		return nil, err
 	}
 
-	return &LobbyScorer{
-		scorers: make(map[string]Scorer),
-		config:  config,
-	}
+	return s, nil
 }
 
 func (s *LobbyScorer) SectionKey() string {
 	return "MATCHER_LOBBY_CONFIG"
 }
 
+// UpdateConfig validates the config and swaps scorers of every mode at once,
+// an invalid config is rejected and the current one stays in use.
 func (s *LobbyScorer) UpdateConfig(newCfg *Config) error {
+	if newCfg == nil {
+		return errors.New("matcher config is empty")
+	}
+
+	if err := errors.Join(newCfg.Validate(), ValidateModes(newCfg)); err != nil {
+		return err
+	}
+
 	s.mx.Lock()
 	defer s.mx.Unlock()
 
-	s.config = newCfg
+	var version uint64 = 1
+	if current := s.state.Load(); current != nil {
+		if !maps.Equal(current.config.Modes, newCfg.Modes) {
+			return ErrModesChanged
+		}
 
-	for mode, cfg := range newCfg.Configs {
-		s.scorers[mode] = newScorer(mode, cfg)
+		version = current.snapshot.Version + 1
 	}
 
+	names := ModeNames()
+	state := &scoringState{
+		config:  newCfg,
+		scorers: make(map[string]Scorer, len(names)),
+		snapshot: ScoringSnapshot{
+			Version:   version,
+			AppliedAt: time.Now(),
+			Modes:     make([]ModeScoring, 0, len(names)),
+		},
+	}
+
+	for _, mode := range names {
+		source, cfg := newCfg.Resolve(mode)
+		state.scorers[mode] = newScorer(mode, cfg)
+		state.snapshot.Modes = append(state.snapshot.Modes, ModeScoring{Mode: mode, Source: source, Config: cfg})
+	}
+
+	s.state.Store(state)
+
 	return nil
 }
 
 func (s *LobbyScorer) GetScorer(mode string) Scorer {
-	s.mx.RLock()
-	scorer, ok := s.scorers[mode]
-	s.mx.RUnlock()
+	state := s.state.Load()
 
-	if ok {
+	if scorer, ok := state.scorers[mode]; ok {
 		return scorer
 	}
 
-	s.mx.Lock()
-	defer s.mx.Unlock()
+	return newScorer(mode, state.config.GetConfig(mode))
+}
 
-	if scorer, ok = s.scorers[mode]; ok {
-		return scorer
-	}
+func (s *LobbyScorer) RatingWindow(mode string, wait time.Duration) float64 {
+	return s.state.Load().config.GetConfig(mode).RatingWindow(wait)
+}
 
-	c := s.config.GetConfig(mode)
-	scorer = &DefaultScorer{Config: c}
-	s.scorers[mode] = scorer
+// Snapshot returns the currently applied config, modes are sorted by name.
+func (s *LobbyScorer) Snapshot() ScoringSnapshot {
+	snapshot := s.state.Load().snapshot
+	snapshot.Modes = slices.Clone(snapshot.Modes)
 
-	return scorer
+	return snapshot
 }
 
 func newScorer(mode string, cfg ScoringConfig) Scorer {
-	var s Scorer
-
-	switch mode {
-	case "duel":
-		s = &DuelScorer{cfg}
-	case "battle":
-		s = &BattleScorer{cfg}
-	case "classic":
-		s = &ClassicScorer{cfg}
-	case "mega":
-		s = &MegaScorer{cfg}
-	case "team":
-		s = &TeamScorer{cfg}
-	case "blitz":
-		s = &BlitzScorer{cfg}
-	default:
-		s = &DefaultScorer{cfg}
+	if m, ok := LookupMode(mode); ok {
+		return m.Scorer(cfg)
 	}
 
-	return s
+	return &DefaultScorer{cfg}
 }
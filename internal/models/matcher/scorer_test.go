@@ -0,0 +1,80 @@
+package matcher_test
+
+import (
+	"testing"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/matcher"
+	"github.com/stretchr/testify/require"
+)
+
+func newScoringConfig(maxRatingDiff float64) matcher.ScoringConfig {
+	return matcher.ScoringConfig{
+		RatingWeight:     0.4,
+		CategoryWeight:   0.4,
+		FillWeight:       0.2,
+		MaxRatingDiff:    maxRatingDiff,
+		MinCategoryMatch: 0.3,
+	}
+}
+
+func TestLobbyScorerUpdateConfig(t *testing.T) {
+	s, err := matcher.NewLobbyScorer(&matcher.Config{
+		Configs: map[string]matcher.ScoringConfig{matcher.DefaultConfigKey: newScoringConfig(500)},
+	})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name string
+		cfg  *matcher.Config
+		err  error
+	}{
+		{
+			name: "empty config",
+		},
+		{
+			name: "invalid weight",
+			cfg: &matcher.Config{
+				Configs: map[string]matcher.ScoringConfig{matcher.DefaultConfigKey: {RatingWeight: 2, MaxRatingDiff: 100}},
+			},
+		},
+		{
+			name: "zero max rating diff",
+			cfg: &matcher.Config{
+				Configs: map[string]matcher.ScoringConfig{"classic": newScoringConfig(0)},
+			},
+		},
+		{
+			name: "unknown mode",
+			cfg: &matcher.Config{
+				Configs: map[string]matcher.ScoringConfig{"missing": newScoringConfig(800)},
+			},
+			err: matcher.ErrUnknownMode,
+		},
+		{
+			name: "modes changed",
+			cfg: &matcher.Config{
+				Configs: map[string]matcher.ScoringConfig{matcher.DefaultConfigKey: newScoringConfig(800)},
+				Modes:   map[string]matcher.ModeDefinition{"classic-xl": {Base: "classic", MinPlayers: 2, MaxPlayers: 20}},
+			},
+			err: matcher.ErrModesChanged,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := s.UpdateConfig(tc.cfg)
+			require.Error(t, err)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			}
+
+			snapshot := s.Snapshot()
+			require.Equal(t, uint64(1), snapshot.Version)
+			require.Equal(t, 500.0, s.RatingWindow("classic", 0))
+		})
+	}
+
+	require.NoError(t, s.UpdateConfig(&matcher.Config{
+		Configs: map[string]matcher.ScoringConfig{matcher.DefaultConfigKey: newScoringConfig(800)},
+	}))
+	require.Equal(t, uint64(2), s.Snapshot().Version)
+	require.Equal(t, 800.0, s.RatingWindow("classic", 0))
+}
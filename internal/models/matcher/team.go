@@ -4,10 +4,22 @@ import (
 	"math"
 
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
 )
 
 var _ Scorer = (*TeamScorer)(nil)
 
+func init() {
+	MustRegisterMode(Mode{
+		Name:       "team",
+		Scorer:     func(cfg ScoringConfig) Scorer { return &TeamScorer{cfg} },
+		Provider:   &scorer.TeamScoreProvider{},
+		MinPlayers: 4,
+		MaxPlayers: 4,
+		Teams:      2,
+	})
+}
+
 type TeamScorer struct {
 	Config ScoringConfig
 }
@@ -21,7 +33,7 @@ func (s *TeamScorer) Filter(lobby *models.Lobby, player *models.Player) bool {
 	if len(lobby.Players) > 0 {
 		avgTeamRating := calculateAverageRating(lobby.Players)
 		ratingDiff := math.Abs(float64(player.Rating) - avgTeamRating)
-		return ratingDiff <= s.Config.MaxRatingDiff*1.5
+		return ratingDiff <= ratingWindow(s.Config, lobby, player)*1.5
 	}
 
 	return true
@@ -32,7 +44,7 @@ func (s *TeamScorer) Score(lobby *models.Lobby, player *models.Player) float64 {
 	if len(lobby.Players) > 0 {
 		avgTeamRating := calculateAverageRating(lobby.Players)
 		teamBalanceScore = 1 - math.Min(1,
-			math.Abs(float64(player.Rating)-avgTeamRating)/s.Config.MaxRatingDiff)
+			math.Abs(float64(player.Rating)-avgTeamRating)/ratingWindow(s.Config, lobby, player))
 	} else {
 		teamBalanceScore = 1.0
 	}
@@ -1,14 +1,28 @@
 package models
 
-import "time"
+import (
+	"strings"
+	"time"
+)
+
+const privateLobbyPrefix = "private-"
 
 type Player struct {
 	ID         string    `json:"id"`
 	Rating     int32     `json:"rating"`
 	Categories []int32   `json:"categories"`
+	PartyID    string    `json:"party_id,omitempty"`
 	JoinedAt   time.Time `json:"joined_at"`
 }
 
+// Seat points a player to the lobby they sit in and the stream session holding it,
+// Detached marks a seat whose stream dropped and which a new stream may resume.
+type Seat struct {
+	LobbyID   string `json:"lobby_id"`
+	SessionID string `json:"session_id"`
+	Detached  bool   `json:"detached,omitempty"`
+}
+
 type Lobby struct {
 	ID           string    `json:"id"`
 	Mode         string    `json:"mode"`
@@ -16,37 +30,212 @@ type Lobby struct {
 	Players      []*Player `json:"players"`
 	MinPlayers   int16     `json:"min_players"`
 	MaxPlayers   int16     `json:"max_players"`
+	Teams        int16     `json:"teams,omitempty"`
 	AvgRating    int32     `json:"avg_rating"`
 	CreatedAt    time.Time `json:"created_at"`
 	LastJoinedAt time.Time `json:"last_joined_at"`
 	ExpireAt     time.Time `json:"expire_at"`
 	Version      int16     `json:"version"`
+	Private      bool      `json:"private,omitempty"`
+	HostID       string    `json:"host_id,omitempty"`
+	Code         string    `json:"code,omitempty"`
+
+	ReadyCheck          time.Duration   `json:"ready_check,omitempty"`
+	ReadyCheckStartedAt time.Time       `json:"ready_check_started_at,omitempty"`
+	Accepted            map[string]bool `json:"accepted,omitempty"`
+}
+
+func NewLobby(id, mode string, players []*Player, ttl time.Duration) *Lobby {
+	now := time.Now()
+
+	return &Lobby{
+		ID:         id,
+		Mode:       mode,
+		Categories: collectCategories(players),
+		Players:    players,
+		AvgRating:  countAvgRating(players),
+		CreatedAt:  now,
+		ExpireAt:   now.Add(ttl),
+		Version:    1,
+	}
+}
+
+func NewPrivateLobby(id, code, mode string, host *Player, ttl time.Duration) *Lobby {
+	lobby := NewLobby(privateLobbyPrefix+id, mode, []*Player{host}, ttl)
+	lobby.Private = true
+	lobby.HostID = host.ID
+	lobby.Code = code
+
+	return lobby
+}
+
+func IsPrivateLobby(lobbyID string) bool {
+	return strings.HasPrefix(lobbyID, privateLobbyPrefix)
 }
 
 func (l *Lobby) AddPlayer(player *Player) bool {
-	if int16(len(l.Players)) >= l.MaxPlayers {
+	return l.AddPlayers(player)
+}
+
+func (l *Lobby) AddPlayers(players ...*Player) bool {
+	if l.FreeSeats() < len(players) {
 		return false
 	}
 
-	l.Players = append(l.Players, player)
+	now := time.Now()
+	for _, player := range players {
+		if player.JoinedAt.IsZero() {
+			player.JoinedAt = now
+		}
+
+		l.Players = append(l.Players, player)
+		l.Categories = mergeCategories(l.Categories, player.Categories)
+	}
+
 	l.AvgRating = countAvgRating(l.Players)
-	l.Categories = mergeCategories(l.Categories, player.Categories)
-	player.JoinedAt = time.Now()
-	l.LastJoinedAt = time.Now()
+	l.LastJoinedAt = now
+	l.Version++
+
+	return true
+}
+
+func (l *Lobby) HasPlayer(playerID string) bool {
+	for _, p := range l.Players {
+		if p.ID == playerID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (l *Lobby) OldestJoinedAt() time.Time {
+	var oldest time.Time
+
+	for _, p := range l.Players {
+		if p.JoinedAt.IsZero() {
+			continue
+		}
+
+		if oldest.IsZero() || p.JoinedAt.Before(oldest) {
+			oldest = p.JoinedAt
+		}
+	}
+
+	return oldest
+}
+
+func (l *Lobby) FreeSeats() int {
+	if l.InReadyCheck() {
+		return 0
+	}
+
+	return int(l.MaxPlayers) - len(l.Players)
+}
+
+func (l *Lobby) InReadyCheck() bool {
+	return !l.ReadyCheckStartedAt.IsZero()
+}
+
+func (l *Lobby) StartReadyCheck() {
+	l.ReadyCheckStartedAt = time.Now()
+	l.Accepted = make(map[string]bool, len(l.Players))
+	l.Version++
+}
+
+func (l *Lobby) StopReadyCheck() {
+	l.ReadyCheckStartedAt = time.Time{}
+	l.Accepted = nil
+	l.Version++
+}
+
+func (l *Lobby) AcceptMatch(playerID string) bool {
+	if !l.InReadyCheck() || !l.HasPlayer(playerID) {
+		return false
+	}
+
+	if l.Accepted == nil {
+		l.Accepted = make(map[string]bool, len(l.Players))
+	}
+
+	l.Accepted[playerID] = true
 	l.Version++
 
 	return true
 }
 
+func (l *Lobby) AllAccepted() bool {
+	for _, p := range l.Players {
+		if !l.Accepted[p.ID] {
+			return false
+		}
+	}
+
+	return len(l.Players) > 0
+}
+
+func (l *Lobby) NotAccepted() []*Player {
+	var result []*Player
+
+	for _, p := range l.Players {
+		if !l.Accepted[p.ID] {
+			result = append(result, p)
+		}
+	}
+
+	return result
+}
+
+func (l *Lobby) RemovePlayer(playerID string) bool {
+	for i, p := range l.Players {
+		if p.ID != playerID {
+			continue
+		}
+
+		l.Players = append(l.Players[:i], l.Players[i+1:]...)
+		delete(l.Accepted, playerID)
+		l.AvgRating = countAvgRating(l.Players)
+		l.Categories = collectCategories(l.Players)
+		l.Version++
+
+		return true
+	}
+
+	return false
+}
+
 func (l *Lobby) IncVersion() {
 	l.Version++
 }
 
 func (l *Lobby) CanAddPlayer() bool {
-	return int16(len(l.Players)) < l.MaxPlayers
+	return !l.InReadyCheck() && int16(len(l.Players)) < l.MaxPlayers
+}
+
+func GroupByParty(players []*Player) [][]*Player {
+	parties := make(map[string]int)
+	groups := make([][]*Player, 0, len(players))
+
+	for _, p := range players {
+		if p.PartyID != "" {
+			if idx, ok := parties[p.PartyID]; ok {
+				groups[idx] = append(groups[idx], p)
+				continue
+			}
+			parties[p.PartyID] = len(groups)
+		}
+
+		groups = append(groups, []*Player{p})
+	}
+
+	return groups
 }
 
 func countAvgRating(players []*Player) int32 {
+	if len(players) == 0 {
+		return 0
+	}
+
 	var total int32
 
 	for _, player := range players {
@@ -56,6 +245,16 @@ func countAvgRating(players []*Player) int32 {
 	return total / int32(len(players))
 }
 
+func collectCategories(players []*Player) []int32 {
+	var result []int32
+
+	for _, player := range players {
+		result = mergeCategories(result, player.Categories)
+	}
+
+	return result
+}
+
 func mergeCategories(a, b []int32) []int32 {
 	set := make(map[int32]struct{}, len(a)+len(b))
 
@@ -0,0 +1,100 @@
+package models
+
+import (
+	"math"
+	"time"
+)
+
+// MatchQuality describes how well players of a lobby fit each other.
+type MatchQuality struct {
+	RatingSpread    float64 // highest minus lowest player rating
+	RatingStdDev    float64 // population standard deviation of player ratings
+	CategoryOverlap float64 // mean Jaccard index of category sets over all player pairs
+	FillRatio       float64 // players over lobby max players
+}
+
+func (l *Lobby) MatchQuality() MatchQuality {
+	var q MatchQuality
+
+	if l.MaxPlayers > 0 {
+		q.FillRatio = float64(len(l.Players)) / float64(l.MaxPlayers)
+	}
+
+	if len(l.Players) == 0 {
+		return q
+	}
+
+	minR, maxR := l.Players[0].Rating, l.Players[0].Rating
+	var sum float64
+	for _, p := range l.Players {
+		minR, maxR = min(minR, p.Rating), max(maxR, p.Rating)
+		sum += float64(p.Rating)
+	}
+	q.RatingSpread = float64(maxR - minR)
+
+	mean := sum / float64(len(l.Players))
+	var variance float64
+	for _, p := range l.Players {
+		variance += (float64(p.Rating) - mean) * (float64(p.Rating) - mean)
+	}
+	q.RatingStdDev = math.Sqrt(variance / float64(len(l.Players)))
+
+	q.CategoryOverlap = categoryOverlap(l.Players)
+
+	return q
+}
+
+// PlayerWaits is how long every player with a known join time has waited by now.
+func (l *Lobby) PlayerWaits(now time.Time) []time.Duration {
+	waits := make([]time.Duration, 0, len(l.Players))
+	for _, p := range l.Players {
+		if !p.JoinedAt.IsZero() {
+			waits = append(waits, now.Sub(p.JoinedAt))
+		}
+	}
+
+	return waits
+}
+
+// categoryOverlap averages the Jaccard index of every player pair,
+// a lobby of a single player fully overlaps with itself.
+func categoryOverlap(players []*Player) float64 {
+	if len(players) < 2 {
+		return 1
+	}
+
+	sets := make([]map[int32]struct{}, len(players))
+	for i, p := range players {
+		sets[i] = make(map[int32]struct{}, len(p.Categories))
+		for _, c := range p.Categories {
+			sets[i][c] = struct{}{}
+		}
+	}
+
+	var total float64
+	for i := 0; i < len(sets); i++ {
+		for j := i + 1; j < len(sets); j++ {
+			total += jaccard(sets[i], sets[j])
+		}
+	}
+
+	pairs := len(sets) * (len(sets) - 1) / 2
+
+	return total / float64(pairs)
+}
+
+// jaccard treats two players without categories as a full match, both accept any category.
+func jaccard(a, b map[int32]struct{}) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+
+	var intersection int
+	for c := range a {
+		if _, ok := b[c]; ok {
+			intersection++
+		}
+	}
+
+	return float64(intersection) / float64(len(a)+len(b)-intersection)
+}
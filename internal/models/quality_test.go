@@ -0,0 +1,88 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchQuality(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		lobby    *models.Lobby
+		expected models.MatchQuality
+	}{
+		{
+			name:     "empty lobby",
+			lobby:    &models.Lobby{MaxPlayers: 4},
+			expected: models.MatchQuality{},
+		},
+		{
+			name: "single player",
+			lobby: &models.Lobby{
+				MaxPlayers: 4,
+				Players:    []*models.Player{{ID: "p1", Rating: 1200, Categories: []int32{1}}},
+			},
+			expected: models.MatchQuality{CategoryOverlap: 1, FillRatio: 0.25},
+		},
+		{
+			name: "mixed lobby",
+			lobby: &models.Lobby{
+				MaxPlayers: 4,
+				Players: []*models.Player{
+					{ID: "p1", Rating: 1000, Categories: []int32{1, 2}},
+					{ID: "p2", Rating: 1400, Categories: []int32{2, 3}},
+				},
+			},
+			expected: models.MatchQuality{RatingSpread: 400, RatingStdDev: 200, CategoryOverlap: 1.0 / 3.0, FillRatio: 0.5},
+		},
+		{
+			name: "players without categories",
+			lobby: &models.Lobby{
+				MaxPlayers: 3,
+				Players: []*models.Player{
+					{ID: "p1", Rating: 1000},
+					{ID: "p2", Rating: 1000},
+					{ID: "p3", Rating: 1000},
+				},
+			},
+			expected: models.MatchQuality{CategoryOverlap: 1, FillRatio: 1},
+		},
+		{
+			name: "no max players",
+			lobby: &models.Lobby{
+				Players: []*models.Player{
+					{ID: "p1", Rating: 900, Categories: []int32{1}},
+					{ID: "p2", Rating: 1100, Categories: []int32{2}},
+				},
+			},
+			expected: models.MatchQuality{RatingSpread: 200, RatingStdDev: 100},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			q := tc.lobby.MatchQuality()
+
+			require.InDelta(t, tc.expected.RatingSpread, q.RatingSpread, 0.001)
+			require.InDelta(t, tc.expected.RatingStdDev, q.RatingStdDev, 0.001)
+			require.InDelta(t, tc.expected.CategoryOverlap, q.CategoryOverlap, 0.001)
+			require.InDelta(t, tc.expected.FillRatio, q.FillRatio, 0.001)
+		})
+	}
+}
+
+func TestPlayerWaits(t *testing.T) {
+	now := time.Now()
+
+	lobby := &models.Lobby{
+		Players: []*models.Player{
+			{ID: "p1", JoinedAt: now.Add(-time.Minute)},
+			{ID: "p2"},
+			{ID: "p3", JoinedAt: now.Add(-time.Second * 10)},
+		},
+	}
+
+	require.Equal(t, []time.Duration{time.Minute, time.Second * 10}, lobby.PlayerWaits(now))
+	require.Empty(t, (&models.Lobby{}).PlayerWaits(now))
+}
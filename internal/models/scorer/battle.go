@@ -17,10 +17,8 @@ func (b *BattleScoreProvider) CalculateScore(lobby *models.Lobby) float64 {
 		ratingScore = 1 - math.Min(spread/800.0, 1.0)
 	}
 
-	uniqueCats := countUniqueCategories(lobby)
-	catScore := math.Min(float64(uniqueCats)/6.0, 1.0)
-
-	fillScore := float64(len(lobby.Players)) / 4.0
+	catScore := categoryDiversity(lobby, 1.5)
+	fillScore := fillRatio(lobby)
 
 	return ratingScore*0.6 + catScore*0.25 + fillScore*0.15
 }
@@ -1,18 +1,14 @@
 package scorer
 
-import (
-	"math"
-
-	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
-)
+import "github.com/QuizWars-Ecosystem/lobby-service/internal/models"
 
 var _ Provider = (*ClassicScoreProvider)(nil)
 
 type ClassicScoreProvider struct{}
 
 func (c *ClassicScoreProvider) CalculateScore(lobby *models.Lobby) float64 {
-	catScore := math.Min(float64(countUniqueCategories(lobby))/15.0, 1.0)
-	fillScore := float64(len(lobby.Players)) / 10.0
+	catScore := categoryDiversity(lobby, 1.5)
+	fillScore := fillRatio(lobby)
 
 	balanceScore := 1.0
 	if len(lobby.Players) > 1 {
@@ -12,7 +12,7 @@ var _ Provider = (*DuelScoreProvider)(nil)
 type DuelScoreProvider struct{}
 
 func (d *DuelScoreProvider) CalculateScore(lobby *models.Lobby) float64 {
-	fillScore := float64(len(lobby.Players)) / 2.0
+	fillScore := fillRatio(lobby)
 
 	waitBonus := math.Min(time.Since(lobby.CreatedAt).Seconds()/300, 1.0)
 
@@ -0,0 +1,93 @@
+package scorer
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+)
+
+var _ Provider = Formula{}
+
+// Formula ranks lobbies by weighted components, every component is normalized to [0, 1].
+type Formula struct {
+	FillWeight      float64 `mapstructure:"fill_weight" yaml:"fill_weight"`           // players over lobby max players
+	DiversityWeight float64 `mapstructure:"diversity_weight" yaml:"diversity_weight"` // unique categories over target categories
+	BalanceWeight   float64 `mapstructure:"balance_weight" yaml:"balance_weight"`     // one minus rating spread over max rating spread
+	WaitWeight      float64 `mapstructure:"wait_weight" yaml:"wait_weight"`           // lobby age over max wait
+
+	TargetCategories int           `mapstructure:"target_categories" yaml:"target_categories"` // e.g 8
+	MaxRatingSpread  float64       `mapstructure:"max_rating_spread" yaml:"max_rating_spread"` // e.g 1000
+	MaxWait          time.Duration `mapstructure:"max_wait" yaml:"max_wait"`                   // e.g 5m
+}
+
+// Evaluation is a formula score together with the components it was built from.
+type Evaluation struct {
+	Fill      float64
+	Diversity float64
+	Balance   float64
+	Wait      float64
+	Score     float64
+}
+
+func (f Formula) Validate() error {
+	var errs []error
+
+	for name, weight := range map[string]float64{
+		"fill_weight":      f.FillWeight,
+		"diversity_weight": f.DiversityWeight,
+		"balance_weight":   f.BalanceWeight,
+		"wait_weight":      f.WaitWeight,
+	} {
+		if weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+			errs = append(errs, fmt.Errorf("%s must be a non negative number, got %v", name, weight))
+		}
+	}
+
+	if f.FillWeight+f.DiversityWeight+f.BalanceWeight+f.WaitWeight == 0 {
+		errs = append(errs, errors.New("at least one weight must be positive"))
+	}
+
+	if f.DiversityWeight > 0 && f.TargetCategories <= 0 {
+		errs = append(errs, errors.New("target_categories must be positive when diversity_weight is set"))
+	}
+
+	if f.BalanceWeight > 0 && f.MaxRatingSpread <= 0 {
+		errs = append(errs, errors.New("max_rating_spread must be positive when balance_weight is set"))
+	}
+
+	if f.WaitWeight > 0 && f.MaxWait <= 0 {
+		errs = append(errs, errors.New("max_wait must be positive when wait_weight is set"))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (f Formula) CalculateScore(lobby *models.Lobby) float64 {
+	return f.Evaluate(lobby).Score
+}
+
+func (f Formula) Evaluate(lobby *models.Lobby) Evaluation {
+	var e Evaluation
+
+	e.Fill = fillRatio(lobby)
+
+	if f.TargetCategories > 0 {
+		e.Diversity = math.Min(float64(countUniqueCategories(lobby))/float64(f.TargetCategories), 1)
+	}
+
+	e.Balance = 1
+	if f.MaxRatingSpread > 0 {
+		e.Balance = 1 - math.Min(calculateRatingSpread(lobby)/f.MaxRatingSpread, 1)
+	}
+
+	if f.MaxWait > 0 {
+		e.Wait = math.Min(float64(time.Since(lobby.CreatedAt))/float64(f.MaxWait), 1)
+	}
+
+	e.Score = f.FillWeight*e.Fill + f.DiversityWeight*e.Diversity + f.BalanceWeight*e.Balance + f.WaitWeight*e.Wait
+
+	return e
+}
@@ -0,0 +1,177 @@
+package scorer_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormulaValidate(t *testing.T) {
+	valid := scorer.Formula{
+		FillWeight:       0.4,
+		DiversityWeight:  0.2,
+		BalanceWeight:    0.3,
+		WaitWeight:       0.1,
+		TargetCategories: 8,
+		MaxRatingSpread:  1000,
+		MaxWait:          time.Minute * 5,
+	}
+
+	for _, tc := range []struct {
+		name    string
+		formula func(f scorer.Formula) scorer.Formula
+		valid   bool
+	}{
+		{
+			name:    "valid",
+			formula: func(f scorer.Formula) scorer.Formula { return f },
+			valid:   true,
+		},
+		{
+			name: "fill only",
+			formula: func(scorer.Formula) scorer.Formula {
+				return scorer.Formula{FillWeight: 1}
+			},
+			valid: true,
+		},
+		{
+			name: "negative weight",
+			formula: func(f scorer.Formula) scorer.Formula {
+				f.FillWeight = -0.1
+				return f
+			},
+		},
+		{
+			name: "nan weight",
+			formula: func(f scorer.Formula) scorer.Formula {
+				f.BalanceWeight = math.NaN()
+				return f
+			},
+		},
+		{
+			name: "all weights zero",
+			formula: func(scorer.Formula) scorer.Formula {
+				return scorer.Formula{TargetCategories: 8}
+			},
+		},
+		{
+			name: "diversity without target categories",
+			formula: func(f scorer.Formula) scorer.Formula {
+				f.TargetCategories = 0
+				return f
+			},
+		},
+		{
+			name: "balance without max rating spread",
+			formula: func(f scorer.Formula) scorer.Formula {
+				f.MaxRatingSpread = 0
+				return f
+			},
+		},
+		{
+			name: "wait without max wait",
+			formula: func(f scorer.Formula) scorer.Formula {
+				f.MaxWait = 0
+				return f
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.formula(valid).Validate()
+			if tc.valid {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestFormulaEvaluate(t *testing.T) {
+	formula := scorer.Formula{
+		FillWeight:       0.4,
+		DiversityWeight:  0.2,
+		BalanceWeight:    0.3,
+		WaitWeight:       0.1,
+		TargetCategories: 4,
+		MaxRatingSpread:  1000,
+		MaxWait:          time.Minute * 10,
+	}
+
+	for _, tc := range []struct {
+		name     string
+		lobby    *models.Lobby
+		expected scorer.Evaluation
+	}{
+		{
+			name: "half full",
+			lobby: &models.Lobby{
+				MaxPlayers: 4,
+				CreatedAt:  time.Now().Add(-time.Minute * 5),
+				Players: []*models.Player{
+					{ID: "p1", Rating: 1000, Categories: []int32{1}},
+					{ID: "p2", Rating: 1500, Categories: []int32{1, 2}},
+				},
+			},
+			expected: scorer.Evaluation{Fill: 0.5, Diversity: 0.5, Balance: 0.5, Wait: 0.5},
+		},
+		{
+			name: "components capped",
+			lobby: &models.Lobby{
+				MaxPlayers: 2,
+				CreatedAt:  time.Now().Add(-time.Hour),
+				Players: []*models.Player{
+					{ID: "p1", Rating: 0, Categories: []int32{1, 2, 3}},
+					{ID: "p2", Rating: 3000, Categories: []int32{4, 5, 6}},
+					{ID: "p3", Rating: 100, Categories: []int32{7}},
+				},
+			},
+			expected: scorer.Evaluation{Fill: 1, Diversity: 1, Balance: 0, Wait: 1},
+		},
+		{
+			name:     "empty lobby without max players",
+			lobby:    &models.Lobby{CreatedAt: time.Now()},
+			expected: scorer.Evaluation{Balance: 1},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			e := formula.Evaluate(tc.lobby)
+
+			require.InDelta(t, tc.expected.Fill, e.Fill, 0.001)
+			require.InDelta(t, tc.expected.Diversity, e.Diversity, 0.001)
+			require.InDelta(t, tc.expected.Balance, e.Balance, 0.001)
+			require.InDelta(t, tc.expected.Wait, e.Wait, 0.001)
+
+			score := 0.4*e.Fill + 0.2*e.Diversity + 0.3*e.Balance + 0.1*e.Wait
+			require.InDelta(t, score, e.Score, 0.001)
+			require.InDelta(t, score, formula.CalculateScore(tc.lobby), 0.001)
+		})
+	}
+}
+
+func TestBuiltinFillUsesMaxPlayers(t *testing.T) {
+	players := make([]*models.Player, 4)
+	for i := range players {
+		players[i] = &models.Player{ID: string(rune('a' + i)), Rating: 1000}
+	}
+
+	small := &models.Lobby{MaxPlayers: 4, Players: players, CreatedAt: time.Now()}
+	large := &models.Lobby{MaxPlayers: 16, Players: players, CreatedAt: time.Now()}
+
+	for name, provider := range map[string]scorer.Provider{
+		"classic": &scorer.ClassicScoreProvider{},
+		"mega":    &scorer.MegaScoreProvider{},
+		"team":    &scorer.TeamScoreProvider{},
+		"battle":  &scorer.BattleScoreProvider{},
+		"blitz":   &scorer.BlitzScoreProvider{},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Greater(t, provider.CalculateScore(small), provider.CalculateScore(large))
+		})
+	}
+}
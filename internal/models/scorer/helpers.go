@@ -0,0 +1,25 @@
+package scorer
+
+import (
+	"math"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+)
+
+// fillRatio is the share of the lobby seats already taken.
+func fillRatio(lobby *models.Lobby) float64 {
+	if lobby.MaxPlayers <= 0 {
+		return 0
+	}
+
+	return math.Min(float64(len(lobby.Players))/float64(lobby.MaxPlayers), 1)
+}
+
+// categoryDiversity is the share of unique categories out of perSeat categories for every lobby seat.
+func categoryDiversity(lobby *models.Lobby, perSeat float64) float64 {
+	if lobby.MaxPlayers <= 0 {
+		return 0
+	}
+
+	return math.Min(float64(countUniqueCategories(lobby))/(perSeat*float64(lobby.MaxPlayers)), 1)
+}
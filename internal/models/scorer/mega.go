@@ -9,7 +9,7 @@ var _ Provider = (*MegaScoreProvider)(nil)
 type MegaScoreProvider struct{}
 
 func (m *MegaScoreProvider) CalculateScore(lobby *models.Lobby) float64 {
-	fillScore := float64(len(lobby.Players)) / 128.0
+	fillScore := fillRatio(lobby)
 
 	if fillScore > 0.4 {
 		return fillScore * 1.5
@@ -0,0 +1,93 @@
+package scorer
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/abstractions"
+)
+
+var _ abstractions.ConfigSubscriber[*Config] = (*Ranking)(nil)
+
+type Config struct {
+	Formulas map[string]Formula `mapstructure:"formulas" yaml:"formulas"`
+}
+
+// Ranking holds configured lobby ranking formulas, modes without one keep their built-in provider.
+type Ranking struct {
+	mx        sync.RWMutex
+	formulas  map[string]Formula
+	knownMode func(mode string) bool
+}
+
+func NewRanking(cfg *Config, knownMode func(mode string) bool) (*Ranking, error) {
+	r := &Ranking{
+		formulas:  make(map[string]Formula),
+		knownMode: knownMode,
+	}
+
+	if err := r.UpdateConfig(cfg); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *Ranking) SectionKey() string {
+	return "RANKING"
+}
+
+func (r *Ranking) UpdateConfig(newCfg *Config) error {
+	var formulas map[string]Formula
+	if newCfg != nil {
+		formulas = maps.Clone(newCfg.Formulas)
+	}
+
+	if err := r.Validate(formulas); err != nil {
+		return err
+	}
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.formulas = formulas
+
+	return nil
+}
+
+// Validate checks formulas without applying them.
+func (r *Ranking) Validate(formulas map[string]Formula) error {
+	var errs []error
+
+	for mode, formula := range formulas {
+		if r.knownMode != nil && !r.knownMode(mode) {
+			errs = append(errs, fmt.Errorf("ranking formula of unknown mode %q", mode))
+			continue
+		}
+
+		if err := formula.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("ranking formula of mode %q: %w", mode, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *Ranking) Formula(mode string) (Formula, bool) {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	formula, ok := r.formulas[mode]
+	return formula, ok
+}
+
+// Provider returns the configured formula of the mode, or fallback when there is none.
+func (r *Ranking) Provider(mode string, fallback Provider) Provider {
+	if formula, ok := r.Formula(mode); ok {
+		return formula
+	}
+
+	return fallback
+}
@@ -1,20 +1,14 @@
 package scorer
 
-import (
-	"math"
-
-	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
-)
+import "github.com/QuizWars-Ecosystem/lobby-service/internal/models"
 
 var _ Provider = (*TeamScoreProvider)(nil)
 
 type TeamScoreProvider struct{}
 
 func (t *TeamScoreProvider) CalculateScore(lobby *models.Lobby) float64 {
-	fillScore := float64(len(lobby.Players)) / 4.0
-
-	uniqueCats := countUniqueCategories(lobby)
-	catScore := math.Min(float64(uniqueCats)/10.0, 1.0)
+	fillScore := fillRatio(lobby)
+	catScore := categoryDiversity(lobby, 2.5)
 
 	balanceScore := 1.0
 	if len(lobby.Players) > 1 {
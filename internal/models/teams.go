@@ -0,0 +1,85 @@
+package models
+
+import (
+	"math"
+	"sort"
+)
+
+type teamUnit struct {
+	players []*Player
+	rating  int64
+}
+
+func BalanceTeams(players []*Player, teams int) map[string]int32 {
+	assignments := make(map[string]int32, len(players))
+	if teams < 2 || len(players) == 0 {
+		return assignments
+	}
+
+	units := splitUnits(players)
+	sort.SliceStable(units, func(i, j int) bool {
+		if len(units[i].players) != len(units[j].players) {
+			return len(units[i].players) > len(units[j].players)
+		}
+		return units[i].rating > units[j].rating
+	})
+
+	capacity := int(math.Ceil(float64(len(players)) / float64(teams)))
+	sizes := make([]int, teams)
+	ratings := make([]int64, teams)
+
+	for _, unit := range units {
+		team := pickTeam(unit, sizes, ratings, capacity)
+
+		sizes[team] += len(unit.players)
+		ratings[team] += unit.rating
+
+		for _, p := range unit.players {
+			assignments[p.ID] = int32(team + 1)
+		}
+	}
+
+	return assignments
+}
+
+func pickTeam(unit teamUnit, sizes []int, ratings []int64, capacity int) int {
+	best := -1
+
+	for i := range sizes {
+		if sizes[i]+len(unit.players) > capacity {
+			continue
+		}
+
+		if best == -1 || ratings[i] < ratings[best] {
+			best = i
+		}
+	}
+
+	if best != -1 {
+		return best
+	}
+
+	for i := range sizes {
+		if best == -1 || sizes[i] < sizes[best] {
+			best = i
+		}
+	}
+
+	return best
+}
+
+func splitUnits(players []*Player) []teamUnit {
+	groups := GroupByParty(players)
+	units := make([]teamUnit, 0, len(groups))
+
+	for _, group := range groups {
+		unit := teamUnit{players: group}
+		for _, p := range group {
+			unit.rating += int64(p.Rating)
+		}
+
+		units = append(units, unit)
+	}
+
+	return units
+}
@@ -0,0 +1,97 @@
+package models_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/stretchr/testify/require"
+)
+
+func newTeamPlayers(ratings ...int32) []*models.Player {
+	players := make([]*models.Player, len(ratings))
+	for i, rating := range ratings {
+		players[i] = &models.Player{ID: "player-" + strconv.Itoa(i), Rating: rating}
+	}
+
+	return players
+}
+
+func TestBalanceTeams(t *testing.T) {
+	party := newTeamPlayers(1500, 1400, 1300, 1000)
+	for _, p := range party[:3] {
+		p.PartyID = "party-1"
+	}
+
+	for _, tc := range []struct {
+		name    string
+		players []*models.Player
+		teams   int
+		sizes   []int
+		ratings []int64
+	}{
+		{
+			name:    "even split",
+			players: newTeamPlayers(1000, 1100, 1200, 1300),
+			teams:   2,
+			sizes:   []int{2, 2},
+			ratings: []int64{2300, 2300},
+		},
+		{
+			name:    "odd count",
+			players: newTeamPlayers(1000, 1100, 1200, 1300, 1400),
+			teams:   2,
+			sizes:   []int{3, 2},
+		},
+		{
+			name:    "party larger than a team",
+			players: party,
+			teams:   2,
+			sizes:   []int{3, 1},
+			ratings: []int64{4200, 1000},
+		},
+		{
+			name:    "single team",
+			players: newTeamPlayers(1000, 1100),
+			teams:   1,
+		},
+		{
+			name:  "no players",
+			teams: 2,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assignments := models.BalanceTeams(tc.players, tc.teams)
+
+			if tc.sizes == nil {
+				require.Empty(t, assignments)
+				return
+			}
+
+			require.Len(t, assignments, len(tc.players))
+
+			sizes := make([]int, tc.teams)
+			ratings := make([]int64, tc.teams)
+			for _, p := range tc.players {
+				team := assignments[p.ID]
+				require.True(t, team >= 1 && int(team) <= tc.teams)
+
+				sizes[team-1]++
+				ratings[team-1] += int64(p.Rating)
+			}
+
+			require.ElementsMatch(t, tc.sizes, sizes)
+			if tc.ratings != nil {
+				require.ElementsMatch(t, tc.ratings, ratings)
+			}
+
+			for _, p := range tc.players {
+				for _, other := range tc.players {
+					if p.PartyID != "" && p.PartyID == other.PartyID {
+						require.Equal(t, assignments[p.ID], assignments[other.ID])
+					}
+				}
+			}
+		})
+	}
+}
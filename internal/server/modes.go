@@ -0,0 +1,33 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/config"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/matcher"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
+)
+
+// registerModes adds configured modes to the registry and checks every mode the config refers to exists.
+func registerModes(cfg *config.Config) error {
+	if err := matcher.DefineModes(cfg.Matcher.Modes); err != nil {
+		return fmt.Errorf("error defining modes: %w", err)
+	}
+
+	references := make([]string, 0, len(cfg.Handler.ModeStats)+len(cfg.Lobby.MergeModes))
+	for mode := range cfg.Handler.ModeStats {
+		references = append(references, mode)
+	}
+	references = append(references, cfg.Lobby.MergeModes...)
+
+	if err := matcher.ValidateModes(cfg.Matcher, references...); err != nil {
+		return fmt.Errorf("error validating modes: %w", err)
+	}
+
+	return nil
+}
+
+// newRanking builds lobby ranking formulas, which may only refer to registered modes.
+func newRanking(cfg *config.Config) (*scorer.Ranking, error) {
+	return scorer.NewRanking(cfg.Ranking, matcher.HasMode)
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/clients"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/config"
+	"github.com/redis/go-redis/v9"
+)
+
+const redisPingTimeout = time.Second * 10
+
+func newRedisClient(cfg *config.RedisConfig) (redis.UniversalClient, error) {
+	if len(cfg.URLs) == 0 {
+		return nil, errors.New("no redis urls configured")
+	}
+
+	switch cfg.Mode {
+	case config.RedisModeCluster, "":
+		client, err := clients.NewRedisClusterClient(clients.NewRedisClusterOptions(cfg.URLs))
+		if err != nil {
+			return nil, err
+		}
+
+		return client, nil
+
+	case config.RedisModeStandalone:
+		client, err := clients.NewRedisClient(cfg.URLs[0], nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return client, nil
+
+	case config.RedisModeSentinel:
+		if cfg.MasterName == "" {
+			return nil, errors.New("redis master name is required in sentinel mode")
+		}
+
+		client := redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:    cfg.MasterName,
+			SentinelAddrs: cfg.URLs,
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), redisPingTimeout)
+		defer cancel()
+
+		if err := client.Ping(ctx).Err(); err != nil {
+			_ = client.Close()
+			return nil, fmt.Errorf("redis sentinel ping failed: %w", err)
+		}
+
+		return client, nil
+
+	default:
+		return nil, fmt.Errorf("unknown redis mode: %s", cfg.Mode)
+	}
+}
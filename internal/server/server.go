@@ -6,12 +6,15 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"time"
 
 	"github.com/QuizWars-Ecosystem/go-common/pkg/grpcx/telemetry"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/admin"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/allocator"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/handler"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/lobby"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/matchmaking"
-	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/stats"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/streamer"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/metrics"
 	grpcrecovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
@@ -42,14 +45,19 @@ import (
 var _ abstractions.Server = (*Server)(nil)
 
 type Server struct {
-	grpcServer   *grpc.Server
-	httpServer   *http.Server
-	grpcListener net.Listener
-	httpListener net.Listener
-	consul       *consul.Consul
-	logger       *log.Logger
-	manager      *manager.Manager[config.Config]
-	closer       *closer.Closer
+	grpcServer    *grpc.Server
+	adminServer   *grpc.Server
+	httpServer    *http.Server
+	healthServer  *health.Server
+	handler       *handler.Handler
+	waiter        *lobby.Waiter
+	grpcListener  net.Listener
+	adminListener net.Listener
+	httpListener  net.Listener
+	consul        *consul.Consul
+	logger        *log.Logger
+	manager       *manager.Manager[config.Config]
+	closer        *closer.Closer
 }
 
 func NewServer(ctx context.Context, manager *manager.Manager[config.Config]) (*Server, error) {
@@ -61,6 +69,11 @@ func NewServer(ctx context.Context, manager *manager.Manager[config.Config]) (*S
 
 	manager.Subscribe(logger.SectionKey(), func(cfg *config.Config) error { return logger.UpdateConfig(cfg.Logger) })
 
+	if err := registerModes(cfg); err != nil {
+		logger.Zap().Error("error registering modes", zap.Error(err))
+		return nil, err
+	}
+
 	consulManager, err := consul.NewConsul(cfg.ConsulURL, cfg.Name, cfg.Address, cfg.GRPCPort, logger)
 	if err != nil {
 		logger.Zap().Error("error initializing consul manager", zap.Error(err))
@@ -76,16 +89,6 @@ func NewServer(ctx context.Context, manager *manager.Manager[config.Config]) (*S
 
 	cl.PushCtx(provider.Shutdown)
 
-	redisClient, err := clients.NewRedisClusterClient(
-		clients.NewRedisClusterOptions(cfg.Redis.URLs),
-	)
-	if err != nil {
-		logger.Zap().Error("error initializing redis client", zap.Error(err))
-		return nil, fmt.Errorf("error initializing redis client: %w", err)
-	}
-
-	cl.PushIO(redisClient)
-
 	ns, err := clients.NewNATSClient(clients.DefaultNATSOptions.WithURL(cfg.NATS.URL), logger.Zap())
 	if err != nil {
 		logger.Zap().Error("error initializing nats client", zap.Error(err))
@@ -96,17 +99,47 @@ func NewServer(ctx context.Context, manager *manager.Manager[config.Config]) (*S
 
 	grpcprometheus.EnableHandlingTimeHistogram()
 
-	storage := store.NewStore(redisClient, logger.Zap())
+	ranking, err := newRanking(cfg)
+	if err != nil {
+		logger.Zap().Error("error initializing ranking", zap.Error(err))
+		return nil, fmt.Errorf("error initializing ranking: %w", err)
+	}
+
+	matcher, err := matchmaking.NewMatcher(cfg.Matcher)
+	if err != nil {
+		logger.Zap().Error("error initializing matcher", zap.Error(err))
+		return nil, fmt.Errorf("error initializing matcher: %w", err)
+	}
+
+	storage, err := newStorage(cfg, ranking, cl, logger.Zap())
+	if err != nil {
+		logger.Zap().Error("error initializing lobby store", zap.Error(err))
+		return nil, err
+	}
+
 	streamManager := streamer.NewStreamManager(ns, storage, logger.Zap())
-	matcher := matchmaking.NewMatcher(cfg.Matcher)
-	waiter := lobby.NewWaiter(storage, streamManager, logger.Zap(), cfg.Lobby)
-	hand := handler.NewHandler(streamManager, waiter, matcher, storage, logger.Zap(), cfg.Handler)
+	finder := matchmaking.NewFinder(matcher, storage, logger.Zap())
+	gameAllocator := allocator.NewNATSAllocator(ns, logger.Zap(), cfg.Allocator)
+	queueStats := stats.NewCollector(storage, logger.Zap(), cfg.Stats)
+	waiter := lobby.NewWaiter(storage, streamManager, gameAllocator, finder, queueStats, logger.Zap(), cfg.Lobby)
+	hand := handler.NewHandler(streamManager, waiter, finder, storage, queueStats, logger.Zap(), cfg.Handler)
+
+	cl.PushNE(waiter.Stop)
+
+	mergeCtx, mergeCancel := context.WithCancel(context.Background())
+	cl.PushNE(mergeCancel)
+
+	go waiter.RunMerger(mergeCtx)
+	go waiter.RunSupervisor(mergeCtx)
 
 	manager.Subscribe(hand.SectionKey(), func(cfg *config.Config) error { return hand.UpdateConfig(cfg.Handler) })
 	manager.Subscribe(waiter.SectionKey(), func(cfg *config.Config) error { return waiter.UpdateConfig(cfg.Lobby) })
 	manager.Subscribe(matcher.SectionKey(), func(cfg *config.Config) error { return matcher.UpdateConfig(cfg.Matcher) })
+	manager.Subscribe(ranking.SectionKey(), func(cfg *config.Config) error { return ranking.UpdateConfig(cfg.Ranking) })
+	manager.Subscribe(gameAllocator.SectionKey(), func(cfg *config.Config) error { return gameAllocator.UpdateConfig(cfg.Allocator) })
+	manager.Subscribe(queueStats.SectionKey(), func(cfg *config.Config) error { return queueStats.UpdateConfig(cfg.Stats) })
 
-	grpcServer := grpc.NewServer(
+	serverOptions := []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(
 			grpcrecovery.UnaryServerInterceptor(),
 			grpccommon.ServerMetricsInterceptor(),
@@ -117,7 +150,10 @@ func NewServer(ctx context.Context, manager *manager.Manager[config.Config]) (*S
 				otelgrpc.WithTracerProvider(provider),
 			),
 		),
-	)
+	}
+
+	grpcServer := grpc.NewServer(serverOptions...)
+	adminServer := grpc.NewServer(serverOptions...)
 
 	healthServer := health.NewServer()
 	healthServer.SetServingStatus(fmt.Sprintf("%s-%d", cfg.Name, cfg.GRPCPort), grpc_health_v1.HealthCheckResponse_SERVING)
@@ -126,6 +162,7 @@ func NewServer(ctx context.Context, manager *manager.Manager[config.Config]) (*S
 	cl.PushNE(healthServer.Shutdown)
 
 	lobbyv1.RegisterLobbyServiceServer(grpcServer, hand)
+	lobbyv1.RegisterLobbyAdminServiceServer(adminServer, admin.NewHandler(storage, waiter, matcher, ranking, logger.Zap()))
 
 	metrics.Initialize()
 
@@ -138,15 +175,20 @@ func NewServer(ctx context.Context, manager *manager.Manager[config.Config]) (*S
 
 	if cfg.Local {
 		reflection.Register(grpcServer)
+		reflection.Register(adminServer)
 	}
 
 	return &Server{
-		grpcServer: grpcServer,
-		httpServer: metricsServer,
-		consul:     consulManager,
-		logger:     logger,
-		manager:    manager,
-		closer:     cl,
+		grpcServer:   grpcServer,
+		adminServer:  adminServer,
+		httpServer:   metricsServer,
+		healthServer: healthServer,
+		handler:      hand,
+		waiter:       waiter,
+		consul:       consulManager,
+		logger:       logger,
+		manager:      manager,
+		closer:       cl,
 	}, nil
 }
 
@@ -188,6 +230,21 @@ func (s *Server) Start() error {
 		return s.grpcServer.Serve(s.grpcListener)
 	})
 
+	group.Go(func() error {
+		z.Info("Starting admin server", zap.Int("port", cfg.AdminPort))
+
+		var err error
+		s.adminListener, err = net.Listen("tcp", fmt.Sprintf(":%d", cfg.AdminPort))
+		if err != nil {
+			z.Error("Error starting admin server", zap.Int("port", cfg.AdminPort), zap.Error(err))
+			return err
+		}
+
+		s.closer.PushIO(s.adminListener)
+
+		return s.adminServer.Serve(s.adminListener)
+	})
+
 	err := s.consul.RegisterService()
 	if err != nil {
 		z.Error("Failed to register service in consul registry", zap.String("name", cfg.Name), zap.Error(err))
@@ -203,12 +260,15 @@ func (s *Server) Shutdown(ctx context.Context) error {
 
 	z.Info("Shutting down server gracefully", zap.String("name", cfg.Name))
 
+	s.drain(ctx)
+
 	stopChan := make(chan struct{})
 	go func() {
 		if err := s.httpServer.Shutdown(ctx); err != nil {
 			z.Error("Error shutting down metrics server", zap.Error(err))
 		}
 
+		s.adminServer.GracefulStop()
 		s.grpcServer.GracefulStop()
 		close(stopChan)
 	}()
@@ -217,6 +277,7 @@ func (s *Server) Shutdown(ctx context.Context) error {
 	case <-stopChan:
 	case <-ctx.Done():
 		z.Warn("Graceful shutdown timed out, forcing stop")
+		s.adminServer.Stop()
 		s.grpcServer.Stop()
 	}
 
@@ -224,6 +285,10 @@ func (s *Server) Shutdown(ctx context.Context) error {
 		return fmt.Errorf("shutting down grpc listener: %w", err)
 	}
 
+	if err := s.adminListener.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+		return fmt.Errorf("shutting down admin listener: %w", err)
+	}
+
 	if err := s.httpListener.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
 		return fmt.Errorf("shutting down http listener: %w", err)
 	}
@@ -234,3 +299,27 @@ func (s *Server) Shutdown(ctx context.Context) error {
 
 	return s.closer.Close(ctx)
 }
+
+// drain takes the instance out of discovery before streams are released,
+// so reconnecting players land on a healthy peer.
+func (s *Server) drain(ctx context.Context) {
+	z := s.logger.Zap()
+	cfg := s.manager.Config()
+
+	z.Info("Draining server", zap.String("name", cfg.Name))
+
+	s.healthServer.Shutdown()
+
+	serviceID := fmt.Sprintf("%s-%d", cfg.Name, cfg.GRPCPort)
+	if err := s.consul.Consul().Agent().EnableServiceMaintenance(serviceID, "draining"); err != nil {
+		z.Warn("Failed to mark service unhealthy in consul", zap.Error(err))
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(cfg.DrainDelay):
+	}
+
+	s.handler.Drain()
+	s.waiter.Stop()
+}
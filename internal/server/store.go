@@ -0,0 +1,33 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/DavidMovas/gopherbox/pkg/closer"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/config"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
+	"go.uber.org/zap"
+)
+
+func newStorage(cfg *config.Config, ranking *scorer.Ranking, cl *closer.Closer, logger *zap.Logger) (store.LobbyStore, error) {
+	switch cfg.Store {
+	case config.StoreRedis, "":
+		redisClient, err := newRedisClient(cfg.Redis)
+		if err != nil {
+			return nil, fmt.Errorf("error initializing redis client: %w", err)
+		}
+
+		cl.PushIO(redisClient)
+
+		return store.NewStore(redisClient, store.NewCodec(cfg.Redis.Codec), ranking, logger), nil
+
+	case config.StoreMemory:
+		logger.Warn("Lobbies are kept in memory, they are lost on restart and not shared between instances")
+
+		return store.NewMemoryStore(ranking), nil
+
+	default:
+		return nil, fmt.Errorf("unknown store backend: %s", cfg.Store)
+	}
+}
@@ -14,9 +14,11 @@ import (
 	"github.com/QuizWars-Ecosystem/go-common/pkg/clients"
 	"github.com/QuizWars-Ecosystem/go-common/pkg/log"
 	lobbyv1 "github.com/QuizWars-Ecosystem/lobby-service/gen/external/lobby/v1"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/allocator"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/handler"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/lobby"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/matchmaking"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/stats"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/streamer"
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/config"
@@ -27,11 +29,14 @@ import (
 )
 
 type TestServer struct {
-	grpcServer *grpc.Server
-	listener   net.Listener
-	logger     *log.Logger
-	cfg        *config.Config
-	closer     *closer.Closer
+	grpcServer   *grpc.Server
+	healthServer *health.Server
+	handler      *handler.Handler
+	waiter       *lobby.Waiter
+	listener     net.Listener
+	logger       *log.Logger
+	cfg          *config.Config
+	closer       *closer.Closer
 }
 
 func NewTestServer(_ context.Context, cfg *config.Config) (*TestServer, error) {
@@ -42,6 +47,11 @@ func NewTestServer(_ context.Context, cfg *config.Config) (*TestServer, error) {
 
 	zapLogger := logger.Zap().With(zap.String("Instance ID", uuid.NewString()[0:5]))
 
+	if err := registerModes(cfg); err != nil {
+		zapLogger.Error("error registering modes", zap.Error(err))
+		return nil, err
+	}
+
 	redisClient, err := clients.NewRedisClusterClient(
 		clients.NewRedisClusterOptions(cfg.Redis.URLs).
 			WithDialTimeout(20*time.Second).
@@ -69,11 +79,32 @@ func NewTestServer(_ context.Context, cfg *config.Config) (*TestServer, error) {
 
 	cl.PushNE(ns.Close)
 
-	storage := store.NewStore(redisClient, zapLogger)
+	ranking, err := newRanking(cfg)
+	if err != nil {
+		zapLogger.Error("error initializing ranking", zap.Error(err))
+		return nil, fmt.Errorf("error initializing ranking: %w", err)
+	}
+
+	matcher, err := matchmaking.NewMatcher(cfg.Matcher)
+	if err != nil {
+		zapLogger.Error("error initializing matcher", zap.Error(err))
+		return nil, fmt.Errorf("error initializing matcher: %w", err)
+	}
+
+	storage := store.NewStore(redisClient, store.NewCodec(cfg.Redis.Codec), ranking, zapLogger)
 	streamManager := streamer.NewStreamManager(ns, storage, zapLogger)
-	matcher := matchmaking.NewMatcher(cfg.Matcher)
-	waiter := lobby.NewWaiter(storage, streamManager, zapLogger, cfg.Lobby)
-	hand := handler.NewHandler(streamManager, waiter, matcher, storage, zapLogger, cfg.Handler)
+	finder := matchmaking.NewFinder(matcher, storage, zapLogger)
+	queueStats := stats.NewCollector(storage, zapLogger, cfg.Stats)
+	waiter := lobby.NewWaiter(storage, streamManager, allocator.NewMemoryAllocator(), finder, queueStats, zapLogger, cfg.Lobby)
+	hand := handler.NewHandler(streamManager, waiter, finder, storage, queueStats, zapLogger, cfg.Handler)
+
+	cl.PushNE(waiter.Stop)
+
+	mergeCtx, mergeCancel := context.WithCancel(context.Background())
+	cl.PushNE(mergeCancel)
+
+	go waiter.RunMerger(mergeCtx)
+	go waiter.RunSupervisor(mergeCtx)
 
 	grpcServer := grpc.NewServer()
 
@@ -86,10 +117,13 @@ func NewTestServer(_ context.Context, cfg *config.Config) (*TestServer, error) {
 	lobbyv1.RegisterLobbyServiceServer(grpcServer, hand)
 
 	return &TestServer{
-		grpcServer: grpcServer,
-		logger:     logger,
-		cfg:        cfg,
-		closer:     cl,
+		grpcServer:   grpcServer,
+		healthServer: healthServer,
+		handler:      hand,
+		waiter:       waiter,
+		logger:       logger,
+		cfg:          cfg,
+		closer:       cl,
 	}, nil
 }
 
@@ -112,6 +146,10 @@ func (s *TestServer) Shutdown(ctx context.Context) error {
 	z := s.logger.Zap()
 	z.Info("Shutting down server gracefully", zap.String("name", s.cfg.Name))
 
+	s.healthServer.Shutdown()
+	s.handler.Drain()
+	s.waiter.Stop()
+
 	stopChan := make(chan struct{})
 	go func() {
 		s.grpcServer.GracefulStop()
@@ -4,11 +4,14 @@ import (
 	"time"
 
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/matcher"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
 
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/handler"
 
 	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/lobby"
 
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/stats"
+
 	"github.com/QuizWars-Ecosystem/go-common/pkg/log"
 
 	def "github.com/QuizWars-Ecosystem/go-common/pkg/config"
@@ -60,44 +63,38 @@ func NewTestConfig() *TestConfig {
 			Logger: &log.Config{
 				Level: "info",
 			},
-			Redis: &config.RedisConfig{},
+			Redis: &config.RedisConfig{Mode: config.RedisModeCluster},
 			NATS:  &config.NATSConfig{},
 			Handler: &handler.Config{
-				ModeStats: map[string]handler.StatPair{
-					"classic": {
-						Min: 4,
-						Max: 10,
-					},
-					"battle": {
-						Min: 2,
-						Max: 4,
-					},
-					"blitz": {
-						Min: 3,
-						Max: 6,
-					},
-					"team": {
-						Min: 4,
-						Max: 4,
-					},
-					"duel": {
-						Min: 2,
-						Max: 2,
-					},
-					"mega": {
-						Min: 24,
-						Max: 128,
-					},
-				},
 				LobbyTLL:         time.Minute * 5,
 				MaxLobbyAttempts: 5,
 				TopLobbiesLimit:  100,
 			},
 			Lobby: &lobby.Config{
-				TickerTimeout:    time.Second,
-				MaxLobbyWait:     time.Minute,
-				LobbyIdleExtend:  time.Second * 15,
-				MinReadyDuration: time.Second * 10,
+				TickerTimeout:         time.Second,
+				MaxLobbyWait:          time.Minute,
+				LobbyIdleExtend:       time.Second * 15,
+				MinReadyDuration:      time.Second * 10,
+				MaxAllocationAttempts: 3,
+				MergeInterval:         time.Second * 5,
+				MergeModes:            []string{"classic", "blitz", "mega"},
+				LeaseTTL:              time.Second * 10,
+				SupervisorInterval:    time.Second * 5,
+			},
+			Stats: &stats.Config{
+				SampleSize:      200,
+				RefreshInterval: time.Second * 5,
+			},
+			Ranking: &scorer.Config{
+				Formulas: map[string]scorer.Formula{
+					"mega": {
+						FillWeight:       0.8,
+						DiversityWeight:  0.1,
+						WaitWeight:       0.1,
+						TargetCategories: 10,
+						MaxWait:          time.Minute,
+					},
+				},
 			},
 			Matcher: &matcher.Config{
 				Configs: map[string]matcher.ScoringConfig{
@@ -109,11 +106,14 @@ func NewTestConfig() *TestConfig {
 						MinCategoryMatch: 0.3,
 					},
 					"duel": {
-						RatingWeight:     0.9,
-						CategoryWeight:   0.1,
-						FillWeight:       0.0,
-						MaxRatingDiff:    600,
-						MinCategoryMatch: 0.2,
+						RatingWeight:        0.9,
+						CategoryWeight:      0.1,
+						FillWeight:          0.0,
+						MaxRatingDiff:       600,
+						MinCategoryMatch:    0.2,
+						InitialRatingWindow: 300,
+						RatingWindowGrowth:  10,
+						MaxRatingWindow:     1500,
 					},
 					"battle": {
 						RatingWeight:     0.7,
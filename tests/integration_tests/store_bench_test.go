@@ -0,0 +1,84 @@
+package integration_tests
+
+import (
+	"context"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const benchPlayersPerLobby = 16
+
+type joinFunc func(ctx context.Context, lobbyID string, player *models.Player) error
+
+// BenchmarkRedisAddPlayer compares the single script join, followed by the index pipeline,
+// with the redsync read-modify-write join. Parallel goroutines compete for the same lobbies
+// like players of the integration load do.
+func BenchmarkRedisAddPlayer(b *testing.B) {
+	client := newRedisClusterClient(b)
+	s := store.NewStore(client, store.BinaryCodec{}, &scorer.Ranking{}, zap.NewNop())
+
+	b.Run("ScriptJoin", func(b *testing.B) {
+		benchmarkAddPlayer(b, client, s, s.AddPlayer)
+	})
+
+	b.Run("LockedJoin", func(b *testing.B) {
+		benchmarkAddPlayer(b, client, s, func(ctx context.Context, lobbyID string, player *models.Player) error {
+			_, err := s.UpdateLobby(ctx, lobbyID, func(lobby *models.Lobby) error {
+				if ok := lobby.AddPlayer(player); !ok {
+					return store.ErrLobbyFull
+				}
+
+				return nil
+			})
+
+			return err
+		})
+	})
+}
+
+func benchmarkAddPlayer(b *testing.B, client *redis.ClusterClient, s *store.Store, join joinFunc) {
+	flushRedisCluster(b, client)
+
+	ctx := context.Background()
+
+	lobbies := make([]string, b.N/benchPlayersPerLobby+1)
+	for i := range lobbies {
+		lobby := models.NewLobby(uuid.NewString(), "mega", nil, time.Minute*5)
+		lobby.MaxPlayers = benchPlayersPerLobby
+
+		require.NoError(b, s.AddLobby(ctx, lobby))
+		lobbies[i] = lobby.ID
+	}
+
+	var next, failed atomic.Int64
+
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			i := next.Add(1) - 1
+
+			player := &models.Player{
+				ID:         uuid.NewString(),
+				Rating:     rand.Int31n(10_000),
+				Categories: []int32{rand.Int31n(25), rand.Int31n(25)},
+			}
+
+			if err := join(ctx, lobbies[i/benchPlayersPerLobby], player); err != nil {
+				failed.Add(1)
+			}
+		}
+	})
+
+	b.ReportMetric(float64(failed.Load())/float64(b.N), "failed/op")
+}
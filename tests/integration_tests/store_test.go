@@ -0,0 +1,107 @@
+package integration_tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/QuizWars-Ecosystem/go-common/pkg/clients"
+	"github.com/QuizWars-Ecosystem/go-common/pkg/testing/containers"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/apis/store/storetest"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models"
+	"github.com/QuizWars-Ecosystem/lobby-service/internal/models/scorer"
+	"github.com/QuizWars-Ecosystem/lobby-service/tests/integration_tests/config"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"go.uber.org/zap"
+)
+
+func TestRedisLobbyStore(t *testing.T) {
+	client := newRedisClusterClient(t)
+
+	codecs := []struct {
+		name  string
+		codec store.Codec
+	}{
+		{"JSON", store.JSONCodec{}},
+		{"Binary", store.BinaryCodec{}},
+	}
+
+	for _, tc := range codecs {
+		t.Run(tc.name, func(t *testing.T) {
+			storetest.Run(t, func(t *testing.T) store.LobbyStore {
+				flushRedisCluster(t, client)
+
+				return store.NewStore(client, tc.codec, &scorer.Ranking{}, zap.NewNop())
+			})
+		})
+	}
+}
+
+// TestRedisStoreJoinsBaselineLobby joins a lobby stored as JSON by instances predating the
+// binary codec and the ready check, which has none of the fields added since.
+func TestRedisStoreJoinsBaselineLobby(t *testing.T) {
+	client := newRedisClusterClient(t)
+
+	for _, codec := range []store.Codec{store.JSONCodec{}, store.BinaryCodec{}} {
+		flushRedisCluster(t, client)
+
+		lobbyID := uuid.NewString()
+		now := time.Now().UTC().Format(time.RFC3339Nano)
+		baseline := fmt.Sprintf(`{"id":%q,"mode":"mega","categories":[1,2],`+
+			`"players":[{"id":"player-1","rating":1200,"categories":[1,2],"joined_at":%q}],`+
+			`"min_players":2,"max_players":4,"avg_rating":1200,"created_at":%q,`+
+			`"last_joined_at":%q,"expire_at":%q,"version":1}`,
+			lobbyID, now, now, now, time.Now().Add(time.Minute).UTC().Format(time.RFC3339Nano))
+
+		require.NoError(t, client.Set(t.Context(), fmt.Sprintf("lobby:{%s}", lobbyID), baseline, time.Minute).Err())
+		require.NoError(t, client.Set(t.Context(), fmt.Sprintf("lobby:version:{%s}", lobbyID), 1, time.Minute).Err())
+
+		s := store.NewStore(client, codec, &scorer.Ranking{}, zap.NewNop())
+		require.NoError(t, s.AddPlayer(t.Context(), lobbyID, &models.Player{ID: "player-2", Rating: 1000, Categories: []int32{3}}))
+
+		lobby, err := s.GetLobby(t.Context(), lobbyID)
+		require.NoError(t, err)
+		require.Len(t, lobby.Players, 2)
+		require.Equal(t, int32(1100), lobby.AvgRating)
+		require.ElementsMatch(t, []int32{1, 2, 3}, lobby.Categories)
+		require.Equal(t, int16(2), lobby.Version)
+		require.True(t, lobby.ReadyCheckStartedAt.IsZero())
+	}
+}
+
+func newRedisClusterClient(tb testing.TB) *redis.ClusterClient {
+	cfg := config.NewTestConfig()
+
+	clusterContainer, err := containers.NewRedisClusterContainers(tb.Context(), cfg.Redis)
+	require.NoError(tb, err)
+
+	testcontainers.CleanupContainer(tb, clusterContainer)
+
+	totalNodes := cfg.Redis.Masters + cfg.Redis.Replicas*cfg.Redis.Masters
+
+	urls := make([]string, totalNodes)
+	for i := 0; i < totalNodes; i++ {
+		urls[i] = fmt.Sprintf(":%d", 7000+i)
+	}
+
+	client, err := clients.NewRedisClusterClient(clients.NewRedisClusterOptions(urls))
+	require.NoError(tb, err)
+
+	tb.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	return client
+}
+
+func flushRedisCluster(tb testing.TB, client *redis.ClusterClient) {
+	err := client.ForEachMaster(tb.Context(), func(ctx context.Context, master *redis.Client) error {
+		return master.FlushDB(ctx).Err()
+	})
+	require.NoError(tb, err)
+}